@@ -0,0 +1,203 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var _ ContextValidatable = (*ValidatableBigInt)(nil)
+
+// ValidatableBigInt is a *big.Int that can be validated. Values are compared exactly.
+type ValidatableBigInt struct {
+	n number
+}
+
+// Validate validates a *big.Int, or a json.Number holding an integer, against its schema.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err)
+//	=> data is not a (non-nil) *big.Int or an integer json.Number
+//	=> data fails any of the schema's rules
+func (v *ValidatableBigInt) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a *big.Int, or a json.Number holding an integer, against its schema, passing ctx to
+// context-aware rules. Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableBigInt) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+func (v *ValidatableBigInt) walk(r *run, data any, tag ...string) Errors {
+	return v.n.walk(r, data, tag...)
+}
+
+// Err returns the errors hit while building the schema, such as a nil bound, joined together.
+func (v *ValidatableBigInt) Err() error { return v.n.err() }
+
+// Optional marks the *big.Int as optional. Calling Validate with nil or a nil *big.Int will skip validation.
+func (v *ValidatableBigInt) Optional() *ValidatableBigInt {
+	v.n.optional = true
+	return v
+}
+
+// FailFast stops validating the *big.Int at its first failing rule, rather than running every rule.
+func (v *ValidatableBigInt) FailFast() *ValidatableBigInt {
+	v.n.failFast = true
+	return v
+}
+
+// Catch marks the *big.Int with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// *big.Int or json.Number pointer (or a struct field validated through a struct pointer), the fallback is written
+// into it. The swallowed issues are recorded in warnings, if provided. A nil fallback makes the schema invalid.
+func (v *ValidatableBigInt) Catch(value *big.Int, warnings ...*Warnings) *ValidatableBigInt {
+	if value == nil {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: Catch: nil fallback", ErrInvalidSchema))
+		return v
+	}
+	value = new(big.Int).Set(value)
+	v.n.setCatch(value.String(), warnings, func(data any) {
+		if target, ok := data.(*big.Int); ok && target != nil {
+			target.Set(value)
+		}
+	})
+	return v
+}
+
+func (v *ValidatableBigInt) writes() bool { return v.n.catch != nil }
+
+// Lt appends a rule validating that data is less than the provided max. (data < max)
+func (v *ValidatableBigInt) Lt(max *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.lt(intRat(max), max.String(), msg)
+	return v
+}
+
+// Gt appends a rule validating that data is greater than the provided min. (data > min)
+func (v *ValidatableBigInt) Gt(min *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.gt(intRat(min), min.String(), msg)
+	return v
+}
+
+// Lte appends a rule validating that data is less than or equal to the provided max. (data <= max)
+func (v *ValidatableBigInt) Lte(max *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.lte(intRat(max), max.String(), msg)
+	return v
+}
+
+// Gte appends a rule validating that data is greater than or equal to the provided min. (data >= min)
+func (v *ValidatableBigInt) Gte(min *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.gte(intRat(min), min.String(), msg)
+	return v
+}
+
+// Range appends a rule validating that data is within the provided range. (min <= data <= max)
+func (v *ValidatableBigInt) Range(min, max *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.between(intRat(min), intRat(max), min.String(), max.String(), msg)
+	return v
+}
+
+// Eq appends a rule validating that data is equal to the provided value. (data == to)
+func (v *ValidatableBigInt) Eq(to *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.eq(intRat(to), to.String(), msg)
+	return v
+}
+
+// NotEq appends a rule validating that data is not equal to the provided value. (data != to)
+func (v *ValidatableBigInt) NotEq(to *big.Int, msg ...string) *ValidatableBigInt {
+	v.n.notEq(intRat(to), to.String(), msg)
+	return v
+}
+
+// Positive appends a rule validating that data is greater than zero. (data > 0)
+func (v *ValidatableBigInt) Positive(msg ...string) *ValidatableBigInt {
+	v.n.sign(CodePositive, "Positive", func(sign int) bool { return sign > 0 }, msg)
+	return v
+}
+
+// Negative appends a rule validating that data is less than zero. (data < 0)
+func (v *ValidatableBigInt) Negative(msg ...string) *ValidatableBigInt {
+	v.n.sign(CodeNegative, "Negative", func(sign int) bool { return sign < 0 }, msg)
+	return v
+}
+
+// NonNegative appends a rule validating that data is greater than or equal to zero. (data >= 0)
+func (v *ValidatableBigInt) NonNegative(msg ...string) *ValidatableBigInt {
+	v.n.sign(CodeNonNegative, "NonNegative", func(sign int) bool { return sign >= 0 }, msg)
+	return v
+}
+
+// NonPositive appends a rule validating that data is less than or equal to zero. (data <= 0)
+func (v *ValidatableBigInt) NonPositive(msg ...string) *ValidatableBigInt {
+	v.n.sign(CodeNonPositive, "NonPositive", func(sign int) bool { return sign <= 0 }, msg)
+	return v
+}
+
+// NonZero appends a rule validating that data is not equal to zero. (data != 0)
+func (v *ValidatableBigInt) NonZero(msg ...string) *ValidatableBigInt {
+	v.n.sign(CodeNonZero, "NonZero", func(sign int) bool { return sign != 0 }, msg)
+	return v
+}
+
+// Precision appends a rule validating that data has at most digits digits (e.g. for IDs stored as NUMERIC(20)).
+func (v *ValidatableBigInt) Precision(digits int, msg ...string) *ValidatableBigInt {
+	v.n.precision(digits, msg)
+	return v
+}
+
+// Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data.
+// The function must not modify data.
+func (v *ValidatableBigInt) Custom(fn func(*big.Int) bool, msg ...string) *ValidatableBigInt {
+	v.n.custom(func(n *number) bool { return fn(n.value.Num()) }, msg)
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext. The function must not modify data.
+func (v *ValidatableBigInt) CustomCtx(fn func(context.Context, *big.Int) error, msg ...string) *ValidatableBigInt {
+	v.n.customCtx(func(ctx context.Context, n *number) error { return fn(ctx, n.value.Num()) }, msg)
+	return v
+}
+
+// BigInt returns a ValidatableBigInt for validating a *big.Int.
+func BigInt() *ValidatableBigInt {
+	return &ValidatableBigInt{n: number{kind: "big.Int", parse: parseBigInt}}
+}
+
+func parseBigInt(data any) (*big.Rat, numberDigits, bool) {
+	var i *big.Int
+	switch data := data.(type) {
+	case *big.Int:
+		if data == nil {
+			return nil, numberDigits{}, true
+		}
+		i = data
+	case json.Number:
+		if !isDigits(strings.TrimPrefix(string(data), "-")) {
+			return nil, numberDigits{}, false
+		}
+		var ok bool
+		if i, ok = new(big.Int).SetString(string(data), 10); !ok {
+			return nil, numberDigits{}, false
+		}
+	default:
+		return nil, numberDigits{}, false
+	}
+	digits := numberDigits{}
+	if i.Sign() != 0 {
+		digits.precision = len(new(big.Int).Abs(i).String())
+	}
+	return new(big.Rat).SetInt(i), digits, true
+}
+
+// intRat converts a bound to a big.Rat, or returns nil for a nil bound.
+func intRat(i *big.Int) *big.Rat {
+	if i == nil {
+		return nil
+	}
+	return new(big.Rat).SetInt(i)
+}
@@ -0,0 +1,210 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+var _ ContextValidatable = (*ValidatableBigRat)(nil)
+
+// ValidatableBigRat is a *big.Rat that can be validated. Values are compared exactly.
+type ValidatableBigRat struct {
+	n number
+}
+
+// Validate validates a *big.Rat, or a json.Number, against its schema.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err)
+//	=> data is not a (non-nil) *big.Rat or a json.Number
+//	=> data fails any of the schema's rules
+func (v *ValidatableBigRat) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a *big.Rat, or a json.Number, against its schema, passing ctx to
+// context-aware rules. Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableBigRat) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+func (v *ValidatableBigRat) walk(r *run, data any, tag ...string) Errors {
+	return v.n.walk(r, data, tag...)
+}
+
+// Err returns the errors hit while building the schema, such as a nil bound, joined together.
+func (v *ValidatableBigRat) Err() error { return v.n.err() }
+
+// Optional marks the *big.Rat as optional. Calling Validate with nil or a nil *big.Rat will skip validation.
+func (v *ValidatableBigRat) Optional() *ValidatableBigRat {
+	v.n.optional = true
+	return v
+}
+
+// FailFast stops validating the *big.Rat at its first failing rule, rather than running every rule.
+func (v *ValidatableBigRat) FailFast() *ValidatableBigRat {
+	v.n.failFast = true
+	return v
+}
+
+// Catch marks the *big.Rat with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// *big.Rat pointer (or a struct field validated through a struct pointer), the fallback is written into it, as it
+// is into a json.Number pointer if the fallback has a terminating decimal expansion. The swallowed issues are
+// recorded in warnings, if provided. A nil fallback makes the schema invalid.
+func (v *ValidatableBigRat) Catch(value *big.Rat, warnings ...*Warnings) *ValidatableBigRat {
+	if value == nil {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: Catch: nil fallback", ErrInvalidSchema))
+		return v
+	}
+	value = new(big.Rat).Set(value)
+	text := ""
+	if digits := ratDigits(value); digits.scale >= 0 {
+		text = value.FloatString(digits.scale)
+	}
+	v.n.setCatch(text, warnings, func(data any) {
+		if target, ok := data.(*big.Rat); ok && target != nil {
+			target.Set(value)
+		}
+	})
+	return v
+}
+
+func (v *ValidatableBigRat) writes() bool { return v.n.catch != nil }
+
+// Lt appends a rule validating that data is less than the provided max. (data < max)
+func (v *ValidatableBigRat) Lt(max *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.lt(max, ratText(max), msg)
+	return v
+}
+
+// Gt appends a rule validating that data is greater than the provided min. (data > min)
+func (v *ValidatableBigRat) Gt(min *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.gt(min, ratText(min), msg)
+	return v
+}
+
+// Lte appends a rule validating that data is less than or equal to the provided max. (data <= max)
+func (v *ValidatableBigRat) Lte(max *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.lte(max, ratText(max), msg)
+	return v
+}
+
+// Gte appends a rule validating that data is greater than or equal to the provided min. (data >= min)
+func (v *ValidatableBigRat) Gte(min *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.gte(min, ratText(min), msg)
+	return v
+}
+
+// Range appends a rule validating that data is within the provided range. (min <= data <= max)
+func (v *ValidatableBigRat) Range(min, max *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.between(min, max, ratText(min), ratText(max), msg)
+	return v
+}
+
+// Eq appends a rule validating that data is equal to the provided value. (data == to)
+func (v *ValidatableBigRat) Eq(to *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.eq(to, ratText(to), msg)
+	return v
+}
+
+// NotEq appends a rule validating that data is not equal to the provided value. (data != to)
+func (v *ValidatableBigRat) NotEq(to *big.Rat, msg ...string) *ValidatableBigRat {
+	v.n.notEq(to, ratText(to), msg)
+	return v
+}
+
+// Positive appends a rule validating that data is greater than zero. (data > 0)
+func (v *ValidatableBigRat) Positive(msg ...string) *ValidatableBigRat {
+	v.n.sign(CodePositive, "Positive", func(sign int) bool { return sign > 0 }, msg)
+	return v
+}
+
+// Negative appends a rule validating that data is less than zero. (data < 0)
+func (v *ValidatableBigRat) Negative(msg ...string) *ValidatableBigRat {
+	v.n.sign(CodeNegative, "Negative", func(sign int) bool { return sign < 0 }, msg)
+	return v
+}
+
+// NonNegative appends a rule validating that data is greater than or equal to zero. (data >= 0)
+func (v *ValidatableBigRat) NonNegative(msg ...string) *ValidatableBigRat {
+	v.n.sign(CodeNonNegative, "NonNegative", func(sign int) bool { return sign >= 0 }, msg)
+	return v
+}
+
+// NonPositive appends a rule validating that data is less than or equal to zero. (data <= 0)
+func (v *ValidatableBigRat) NonPositive(msg ...string) *ValidatableBigRat {
+	v.n.sign(CodeNonPositive, "NonPositive", func(sign int) bool { return sign <= 0 }, msg)
+	return v
+}
+
+// NonZero appends a rule validating that data is not equal to zero. (data != 0)
+func (v *ValidatableBigRat) NonZero(msg ...string) *ValidatableBigRat {
+	v.n.sign(CodeNonZero, "NonZero", func(sign int) bool { return sign != 0 }, msg)
+	return v
+}
+
+// Scale appends a rule validating that data has at most places digits after the decimal point, when written as a
+// decimal (e.g. 1/4 has 2). Fractions without a terminating decimal (e.g. 1/3) fail the rule.
+func (v *ValidatableBigRat) Scale(places int, msg ...string) *ValidatableBigRat {
+	v.n.scale(places, msg)
+	return v
+}
+
+// Precision appends a rule validating that data has at most digits digits when written as a decimal, not counting
+// leading zeros (e.g. 12.5 has 3), as in SQL's NUMERIC(precision, scale). Fractions without a terminating
+// decimal (e.g. 1/3) fail the rule.
+func (v *ValidatableBigRat) Precision(digits int, msg ...string) *ValidatableBigRat {
+	v.n.precision(digits, msg)
+	return v
+}
+
+// Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data.
+// The function must not modify data.
+func (v *ValidatableBigRat) Custom(fn func(*big.Rat) bool, msg ...string) *ValidatableBigRat {
+	v.n.custom(func(n *number) bool { return fn(n.value) }, msg)
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext. The function must not modify data.
+func (v *ValidatableBigRat) CustomCtx(fn func(context.Context, *big.Rat) error, msg ...string) *ValidatableBigRat {
+	v.n.customCtx(func(ctx context.Context, n *number) error { return fn(ctx, n.value) }, msg)
+	return v
+}
+
+// BigRat returns a ValidatableBigRat for validating a *big.Rat.
+func BigRat() *ValidatableBigRat {
+	return &ValidatableBigRat{n: number{kind: "big.Rat", parse: parseBigRat}}
+}
+
+func parseBigRat(data any) (*big.Rat, numberDigits, bool) {
+	var r *big.Rat
+	switch data := data.(type) {
+	case *big.Rat:
+		if data == nil {
+			return nil, numberDigits{}, true
+		}
+		// copied, so rules can't modify data
+		r = new(big.Rat).Set(data)
+	case json.Number:
+		var ok bool
+		if r, _, ok = parseDecimal(string(data)); !ok {
+			return nil, numberDigits{}, false
+		}
+	default:
+		return nil, numberDigits{}, false
+	}
+	return r, ratDigits(r), true
+}
+
+// ratText returns a bound as it's shown in messages.
+func ratText(r *big.Rat) string {
+	if r == nil {
+		return "<nil>"
+	}
+	return r.RatString()
+}
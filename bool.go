@@ -1,96 +1,261 @@
 package z
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MarcusSanchez/go-z/internal"
+	"strings"
 )
 
-var _ Validatable = (*ValidatableBool)(nil)
+var _ ContextValidatable = (*ValidatableBool)(nil)
 
-// ValidatableBool is a bool that can be validated.
+// ValidatableBool is a bool that can be validated. A *bool is validated as the bool it points to, with nil
+// (like a nil interface) skipped if the schema is Optional.
 type ValidatableBool struct {
-	tag      *string
-	value    bool
-	rules    []rule
+	tag   *string
+	value bool
+	// rules build the rules of the schema for the copy of it made to validate a value (see walk).
+	rules    []func(v *ValidatableBool) rule
 	optional bool
+	failFast bool
+	run      *run
+	fallback *fallback[bool]
+	// coerce accepts strings from the vocabulary (or defaultBoolVocabulary, if it's nil) in place of bools.
+	coerce     bool
+	strict     bool
+	vocabulary map[string]bool
+	// errs are the errors hit while building the schema.
+	errs []error
+}
+
+// defaultBoolVocabulary is the vocabulary a coercing ValidatableBool accepts by default.
+var defaultBoolVocabulary = map[string]bool{
+	"true": true, "1": true, "yes": true, "on": true,
+	"false": false, "0": false, "no": false, "off": false,
 }
 
 // Validate validates a bool against its schema.
 //
 //	Returns Errors if:
-//	=> data is not a bool
+//	=> the schema is invalid (see Err)
+//	=> data is not a bool, a bool pointer or, when coercing, a string in the vocabulary
 //	=> data fails any of the schema's rules
 func (v *ValidatableBool) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a bool against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableBool) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+// walk validates data against a copy of the schema, which holds the value being validated, so the schema can
+// validate any number of values at a time.
+func (v *ValidatableBool) walk(r *run, data any, tag ...string) Errors {
+	c := *v
+	_, errs := c.walkCopy(r, data, tag...)
+	return errs
+}
+
+// walkCopy is walk for the copy of the schema made to validate data. Reports whether the copy holds a value
+// afterwards: data was set (rather than nil, a nil pointer or, when coercing, an empty string) and valid, or the
+// fallback was taken in its place.
+func (v *ValidatableBool) walkCopy(r *run, data any, tag ...string) (bool, Errors) {
 	if len(tag) > 0 {
 		v.tag = &tag[0]
 	}
-	if v.optional {
-		if data == nil {
-			return nil
+	if err := v.Err(); err != nil {
+		r.fail(err)
+		return false, nil
+	}
+	var target *bool
+	set := data != nil
+	switch value := data.(type) {
+	case *bool:
+		if set = value != nil; set {
+			target = value
+			data = *value
+		}
+	case string:
+		if v.coerce {
+			data, set = v.lookup(value)
 		}
-		if value, ok := data.(*bool); ok {
-			if value == nil {
-				return nil
+	case *string:
+		if v.coerce {
+			if set = value != nil; set {
+				data, set = v.lookup(*value)
 			}
-			data = *value
 		}
 	}
+	if !set {
+		if v.optional {
+			return false, nil
+		}
+		data = nil
+	}
 
 	var ok bool
 	if v.value, ok = data.(bool); !ok {
-		if v.tag == nil {
-			return internal.NewValidationErrors("failed validation for <bool>")
-		}
-		return internal.NewValidationErrors("<%s> failed validation for <bool>", *v.tag)
-	}
-	vErrors := make([]string, 0, len(v.rules))
-	for _, rule := range v.rules {
-		if err := rule(); err != "" {
-			vErrors = append(vErrors, err)
-		}
+		return v.fallback != nil, r.report(v.fail(target, typeMismatch("bool", v.tag, data)))
 	}
+	v.run = r
+	vErrors := v.run.check(bind(v, v.rules), v.failFast, v.tag)
 	if len(vErrors) > 0 {
-		return internal.NewValidationErrors(vErrors...)
+		return v.fallback != nil, r.report(v.fail(target, internal.NewValidationIssues(vErrors...)))
 	}
-	return nil
+	return true, nil
 }
 
-// Optional marks the bool as optional. Calling Validate with nil or a nil bool pointer will skip validation.
+// fail applies the schema's fallback (if any) to errs, taking the fallback as the value.
+func (v *ValidatableBool) fail(target *bool, errs Errors) Errors {
+	if v.fallback != nil {
+		v.value = v.fallback.value
+	}
+	return v.fallback.apply(target, errs)
+}
+
+// lookup coerces s to a bool using the schema's vocabulary, returning s itself (failing validation) if it isn't
+// in the vocabulary. Reports false if s is empty (once trimmed, unless strict) and so counts as unset.
+func (v *ValidatableBool) lookup(s string) (any, bool) {
+	vocabulary := v.vocabulary
+	if vocabulary == nil {
+		vocabulary = defaultBoolVocabulary
+	}
+	if value, ok := vocabulary[s]; ok {
+		return value, true
+	}
+	if !v.strict {
+		s = strings.TrimSpace(s)
+		for word, value := range vocabulary {
+			if strings.EqualFold(word, s) {
+				return value, true
+			}
+		}
+	}
+	return s, s != ""
+}
+
+// Parse validates data against the schema, as Validate does, and returns the bool it holds (coerced, if the schema
+// coerces strings). The bool is nil if data is unset (nil, a nil pointer or, when coercing, an empty string) or fails
+// validation, so PATCH handlers can tell a field left out from one set to false. If validation fails and the schema
+// has a fallback (see Catch), the fallback is returned instead.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err)
+//	=> data is not a bool or, when coercing, a string in the vocabulary
+//	=> data fails any of the schema's rules
+func (v *ValidatableBool) Parse(data any, tag ...string) (*bool, Errors) {
+	var parsed *bool
+	errs := validate(walkFunc(func(r *run, data any, tags ...string) Errors {
+		c := *v
+		held, errs := c.walkCopy(r, data, tags...)
+		if errs != nil || r.err() != nil || !held {
+			return errs
+		}
+		parsed = &c.value
+		return errs
+	}), data, tag...)
+	return parsed, errs
+}
+
+// Err returns the errors hit while building the schema, such as a vocabulary with a word that is both true and
+// false, joined together.
+func (v *ValidatableBool) Err() error {
+	return errors.Join(v.errs...)
+}
+
+// Optional marks the bool as optional. Calling Validate with nil, a nil pointer or (when coercing) an empty string
+// will skip validation.
 func (v *ValidatableBool) Optional() *ValidatableBool {
 	v.optional = true
 	return v
 }
 
+// FailFast stops validating the bool at its first failing rule, rather than running every rule.
+func (v *ValidatableBool) FailFast() *ValidatableBool {
+	v.failFast = true
+	return v
+}
+
+// Catch marks the bool with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// bool pointer (or a struct field validated through a struct pointer), the fallback is written into it.
+// The swallowed issues are recorded in warnings, if provided.
+func (v *ValidatableBool) Catch(value bool, warnings ...*Warnings) *ValidatableBool {
+	v.fallback = newFallback(value, warnings)
+	return v
+}
+
+func (v *ValidatableBool) writes() bool { return v.fallback != nil }
+
+// Coerce accepts strings in place of bools, for form posts and environment variables: "true", "1", "yes" and "on"
+// are true, and "false", "0", "no" and "off" false. Words are matched ignoring case and surrounding whitespace,
+// unless the schema is Strict. An empty string counts as unset, like nil.
+func (v *ValidatableBool) Coerce() *ValidatableBool {
+	v.coerce = true
+	return v
+}
+
+// Vocabulary accepts strings in place of bools, as Coerce does, with truthy words as true and falsy words as false
+// instead of the default vocabulary.
+func (v *ValidatableBool) Vocabulary(truthy, falsy []string) *ValidatableBool {
+	v.coerce = true
+	v.vocabulary = make(map[string]bool, len(truthy)+len(falsy))
+	for _, word := range truthy {
+		v.vocabulary[word] = true
+	}
+	for _, word := range falsy {
+		for _, t := range truthy {
+			if strings.EqualFold(word, t) {
+				v.errs = append(v.errs, fmt.Errorf("%w: Vocabulary: %q is both true and false", ErrInvalidSchema, word))
+			}
+		}
+		v.vocabulary[word] = false
+	}
+	return v
+}
+
+// Strict makes coercion (see Coerce) match words exactly, without ignoring case or surrounding whitespace.
+func (v *ValidatableBool) Strict() *ValidatableBool {
+	v.strict = true
+	return v
+}
+
 // True appends a rule validating that data is true. (data == true)
 func (v *ValidatableBool) True(msg ...string) *ValidatableBool {
-	v.rules = append(v.rules, func() string {
-		if v.value == true {
-			return ""
-		}
-		if len(msg) > 0 {
-			return msg[0]
-		}
-		if v.tag == nil {
-			return fmt.Sprintf("failed <bool> validation for <True>")
-		}
-		return fmt.Sprintf("<%s> failed <bool> validation for <True>", *v.tag)
+	v.rules = append(v.rules, func(v *ValidatableBool) rule {
+		return rule{code: CodeTrue, check: func() string {
+			if v.value == true {
+				return ""
+			}
+			if len(msg) > 0 {
+				return msg[0]
+			}
+			if v.tag == nil {
+				return fmt.Sprintf("failed <bool> validation for <True>")
+			}
+			return fmt.Sprintf("<%s> failed <bool> validation for <True>", *v.tag)
+		}}
 	})
 	return v
 }
 
 // False appends a rule validating that data is false. (data == false)
 func (v *ValidatableBool) False(msg ...string) *ValidatableBool {
-	v.rules = append(v.rules, func() string {
-		if v.value == false {
-			return ""
-		}
-		if len(msg) > 0 {
-			return msg[0]
-		}
-		if v.tag == nil {
-			return fmt.Sprintf("failed <bool> validation for <False>")
-		}
-		return fmt.Sprintf("<%s> failed <bool> validation for <False>", *v.tag)
+	v.rules = append(v.rules, func(v *ValidatableBool) rule {
+		return rule{code: CodeFalse, check: func() string {
+			if v.value == false {
+				return ""
+			}
+			if len(msg) > 0 {
+				return msg[0]
+			}
+			if v.tag == nil {
+				return fmt.Sprintf("failed <bool> validation for <False>")
+			}
+			return fmt.Sprintf("<%s> failed <bool> validation for <False>", *v.tag)
+		}}
 	})
 	return v
 }
@@ -0,0 +1,49 @@
+package z
+
+import "testing"
+
+func TestBoolParse(t *testing.T) {
+	falsy, truthy := false, true
+	tests := []struct {
+		name   string
+		schema func() *ValidatableBool
+		data   []any
+		// want is what parsing the last of data returns, nil meaning no bool
+		want *bool
+		errs bool
+	}{
+		{"set", func() *ValidatableBool { return Bool() }, []any{true}, &truthy, false},
+		{"unset", func() *ValidatableBool { return Bool().Optional() }, []any{nil}, nil, false},
+		{"nil pointer", func() *ValidatableBool { return Bool().Optional() }, []any{(*bool)(nil)}, nil, false},
+		{"required", func() *ValidatableBool { return Bool() }, []any{nil}, nil, true},
+		{"invalid", func() *ValidatableBool { return Bool().True() }, []any{false}, nil, true},
+		{"fallback", func() *ValidatableBool { return Bool().True().Catch(true) }, []any{false}, &truthy, false},
+		{"required fallback", func() *ValidatableBool { return Bool().Catch(false) }, []any{nil}, &falsy, false},
+		// an optional schema with a fallback leaves unset data unset, rather than returning the last value parsed
+		{"unset fallback", func() *ValidatableBool { return Bool().Optional().Catch(false) }, []any{true, nil}, nil, false},
+		{"empty string", func() *ValidatableBool { return Bool().Coerce().Optional().Catch(false) }, []any{"yes", ""}, nil, false},
+	}
+	for _, tt := range tests {
+		schema := tt.schema()
+		var got *bool
+		var errs Errors
+		for _, data := range tt.data {
+			got, errs = schema.Parse(data)
+		}
+		if (errs != nil) != tt.errs {
+			t.Errorf("%s: errors = %v, want errors: %v", tt.name, errs, tt.errs)
+		}
+		switch {
+		case got == nil && tt.want == nil:
+		case got == nil || tt.want == nil || *got != *tt.want:
+			t.Errorf("%s: Parse = %v, want %v", tt.name, describeBool(got), describeBool(tt.want))
+		}
+	}
+}
+
+func describeBool(b *bool) any {
+	if b == nil {
+		return nil
+	}
+	return *b
+}
@@ -0,0 +1,138 @@
+package z
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+var _ ContextValidatable = (*CatchStruct)(nil)
+
+// Warnings records the validation issues swallowed by schemas marked with Catch.
+// It is safe to share a single Warnings between several schemas.
+type Warnings struct {
+	mu     sync.Mutex
+	issues []Issue
+}
+
+// All returns the message of every issue recorded so far.
+func (w *Warnings) All() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	messages := make([]string, len(w.issues))
+	for i, issue := range w.issues {
+		messages[i] = issue.Message
+	}
+	return messages
+}
+
+// Issues returns a copy of every issue recorded so far, with their codes, paths and params, in the same order as All.
+func (w *Warnings) Issues() []Issue {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Issue(nil), w.issues...)
+}
+
+// Reset discards every issue recorded so far.
+func (w *Warnings) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.issues = nil
+}
+
+func (w *Warnings) add(issues ...Issue) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.issues = append(w.issues, issues...)
+}
+
+// writer is implemented by schemas that may write a value back into the data they validate.
+// Struct hands these schemas the address of their field (when it has one) instead of a copy.
+type writer interface {
+	writes() bool
+}
+
+// fallback holds the value a primitive falls back to when it is marked with Catch.
+type fallback[T any] struct {
+	value    T
+	warnings *Warnings
+}
+
+func newFallback[T any](value T, warnings []*Warnings) *fallback[T] {
+	f := &fallback[T]{value: value}
+	if len(warnings) > 0 {
+		f.warnings = warnings[0]
+	}
+	return f
+}
+
+// apply swallows errs, writing the fallback value into target (if any) and recording errs as warnings.
+// If f is nil, errs is returned untouched.
+func (f *fallback[T]) apply(target *T, errs Errors) Errors {
+	if f == nil {
+		return errs
+	}
+	if target != nil {
+		*target = f.value
+	}
+	f.warnings.add(errs.Issues()...)
+	return nil
+}
+
+// CatchStruct is a struct schema that falls back to a value instead of failing validation.
+type CatchStruct struct {
+	schema   Validatable
+	value    any
+	warnings *Warnings
+}
+
+// Validate validates a struct or struct pointer against its schema. If validation fails,
+// the issues are recorded as warnings and, if data is a non-nil struct pointer, the
+// fallback value is written into it.
+//
+//	Never returns Errors.
+func (s *CatchStruct) Validate(data any, tags ...string) Errors {
+	return validate(s, data, tags...)
+}
+
+// ValidateContext validates a struct or struct pointer against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (s *CatchStruct) ValidateContext(ctx context.Context, data any, tags ...string) (Errors, error) {
+	return validateContext(ctx, s, data, tags...)
+}
+
+func (s *CatchStruct) walk(r *run, data any, tags ...string) Errors {
+	// the errors found within the schema are swallowed, so they don't count towards the run's error limit
+	errs := r.untallied().walk(s.schema, data, tags...)
+	if errs == nil {
+		return nil
+	}
+	if target := reflect.ValueOf(data); target.Kind() == reflect.Ptr && !target.IsNil() {
+		value := reflect.ValueOf(s.value)
+		if value.Kind() == reflect.Ptr && value.Type() == target.Type() {
+			// the fallback may be given as a pointer to the struct as well
+			value = value.Elem()
+		}
+		if value.IsValid() && value.Type().AssignableTo(target.Elem().Type()) {
+			target.Elem().Set(value)
+		}
+	}
+	s.warnings.add(errs.Issues()...)
+	return nil
+}
+
+func (s *CatchStruct) writes() bool { return true }
+
+// Err returns the errors hit while building the schemas of the struct's fields. See Struct.Err.
+func (s *CatchStruct) Err() error { return schemaErr(s, "") }
+
+func newCatchStruct(schema Validatable, value any, warnings []*Warnings) *CatchStruct {
+	s := &CatchStruct{schema: schema, value: value}
+	if len(warnings) > 0 {
+		s.warnings = warnings[0]
+	}
+	return s
+}
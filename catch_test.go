@@ -0,0 +1,109 @@
+package z
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestNumberCatch(t *testing.T) {
+	type order struct {
+		ID     *big.Int    `z:"id"`
+		Ratio  *big.Rat    `z:"ratio"`
+		Price  string      `z:"price"`
+		Total  json.Number `z:"total"`
+		Amount string      `z:"amount"`
+	}
+	var warnings Warnings
+	schema := Struct{
+		"id":     BigInt().Positive().Catch(big.NewInt(1), &warnings),
+		"ratio":  BigRat().Lte(big.NewRat(1, 1)).Catch(big.NewRat(1, 2), &warnings),
+		"price":  Decimal().Scale(2).Catch("0.00", &warnings),
+		"total":  Decimal().NonNegative().Catch("0", &warnings),
+		"amount": Money("currency").Catch("0", &warnings),
+	}
+	o := order{ID: big.NewInt(-5), Ratio: big.NewRat(3, 2), Price: "1.999", Total: "-1", Amount: "x"}
+	if errs := schema.Validate(&o); errs != nil {
+		t.Fatalf("Validate = %v, want no errors", errs)
+	}
+	if o.ID.Cmp(big.NewInt(1)) != 0 || o.Ratio.Cmp(big.NewRat(1, 2)) != 0 || o.Price != "0.00" || o.Total != "0" || o.Amount != "0" {
+		t.Errorf("got %v %v %q %q %q, want the fallbacks written", o.ID, o.Ratio, o.Price, o.Total, o.Amount)
+	}
+	if got := len(warnings.All()); got != 5 {
+		t.Errorf("recorded %d warnings, want 5: %q", got, warnings.All())
+	}
+
+	// a passing value is left alone, and a bad fallback makes the schema invalid
+	n := json.Number("7")
+	if errs := BigInt().Positive().Catch(big.NewInt(1)).Validate(&n); errs != nil || n != "7" {
+		t.Errorf("Validate(7) = %v, %q; want no errors and 7 untouched", errs, n)
+	}
+	if err := BigInt().Catch(nil).Err(); err == nil {
+		t.Error("BigInt().Catch(nil).Err() = nil, want an error")
+	}
+	if err := Decimal().Catch("ten").Err(); err == nil {
+		t.Error(`Decimal().Catch("ten").Err() = nil, want an error`)
+	}
+}
+
+func TestGeoJSONCatchAndFailFast(t *testing.T) {
+	const point = `{"type":"Point","coordinates":[0,0]}`
+	var warnings Warnings
+	text := `{"type":"Point","coordinates":[200,100]}`
+	if errs := GeoJSON().Catch(point, &warnings).Validate(&text); errs != nil || text != point {
+		t.Errorf("Validate = %v, %s; want no errors and the fallback written", errs, text)
+	}
+	if len(warnings.All()) != 2 {
+		t.Errorf("recorded %q, want 2 warnings", warnings.All())
+	}
+	raw := json.RawMessage(`{}`)
+	if errs := GeoJSON().Catch(point).Validate(&raw); errs != nil || string(raw) != point {
+		t.Errorf("Validate = %v, %s; want no errors and the fallback written", errs, raw)
+	}
+
+	bad := `{"type":"MultiPoint","coordinates":[[200,0],[0,100]]}`
+	if got := len(GeoJSON().Validate(bad).All()); got < 2 {
+		t.Fatalf("found %d issues, want several", got)
+	}
+	errs := GeoJSON().FailFast().Validate(bad, "route")
+	if all := errs.All(); len(all) != 1 || !strings.HasPrefix(all[0], "<route") {
+		t.Errorf("FailFast found %q, want exactly one issue", all)
+	}
+}
+
+// Warnings keep the swallowed issues whole, so their codes and paths can be logged or matched on.
+func TestWarningsIssues(t *testing.T) {
+	type user struct {
+		Name string `z:"name"`
+		Age  int    `z:"age"`
+	}
+	var warnings Warnings
+	schema := Struct{
+		"name": String().Min(3).Catch("anonymous", &warnings),
+		"age":  Int().Positive(),
+	}.Catch(user{Name: "anonymous", Age: 1}, &warnings)
+
+	u := user{Name: "al", Age: -1}
+	if errs := schema.Validate(&u); errs != nil {
+		t.Fatalf("Validate = %v, want no errors", errs)
+	}
+	issues := warnings.Issues()
+	if len(issues) != 2 || issues[0].Code != CodeMin || issues[0].Path != "name" ||
+		issues[1].Code != CodePositive || issues[1].Path != "age" {
+		t.Fatalf("Issues() = %+v, want a min issue at name, then a positive issue at age", issues)
+	}
+	if all := warnings.All(); len(all) != 2 || all[0] != issues[0].Message || all[1] != issues[1].Message {
+		t.Errorf("All() = %q, want the messages of Issues()", all)
+	}
+
+	// the returned issues are a copy
+	issues[0].Code = "changed"
+	if warnings.Issues()[0].Code != CodeMin {
+		t.Error("modifying the result of Issues() changed the recorded issues")
+	}
+	warnings.Reset()
+	if len(warnings.Issues()) != 0 || len(warnings.All()) != 0 {
+		t.Errorf("after Reset, Issues() = %+v, want none", warnings.Issues())
+	}
+}
@@ -0,0 +1,129 @@
+package z
+
+// Codes identify the rule an Issue failed. Unlike messages, they don't change with the data, tag,
+// or a custom message, so they're safe to match on.
+const (
+	// CodeType is the code of issues for data that isn't of the type a schema validates (e.g. a string given
+	// to z.Int()). Their Params hold the "expected" type and the type "received".
+	CodeType = "type"
+	// CodeRequired is the code of issues for data that is missing (nil, or a nil pointer) but not optional.
+	// Their Params are the same as CodeType's.
+	CodeRequired         = "required"
+	CodeLt               = "lt"
+	CodeGt               = "gt"
+	CodeLte              = "lte"
+	CodeGte              = "gte"
+	CodeRange            = "range"
+	CodeEq               = "eq"
+	CodeNotEq            = "not_eq"
+	CodePositive         = "positive"
+	CodeNegative         = "negative"
+	CodeNonNegative      = "non_negative"
+	CodeNonPositive      = "non_positive"
+	CodeNonZero          = "non_zero"
+	CodeIn               = "in"
+	CodeCustom           = "custom"
+	CodeCustomCtx        = "custom_ctx"
+	CodeTrue             = "true"
+	CodeFalse            = "false"
+	CodeMin              = "min"
+	CodeMax              = "max"
+	CodeEmail            = "email"
+	CodeEmailTLD         = "email_tld"
+	CodeEmailDomain      = "email_domain"
+	CodeEmailDisposable  = "email_disposable"
+	CodeEmailLength      = "email_length"
+	CodeEmailPlus        = "email_plus"
+	CodeNotEmpty         = "not_empty"
+	CodeRegex            = "regex"
+	CodeUUID             = "uuid"
+	CodeURL              = "url"
+	CodeURLScheme        = "url_scheme"
+	CodeURLHost          = "url_host"
+	CodeIP               = "ip"
+	CodeIPv4             = "ipv4"
+	CodeIPv6             = "ipv6"
+	CodeCIDR             = "cidr"
+	CodeHostname         = "hostname"
+	CodeHostPort         = "host_port"
+	CodeMAC              = "mac"
+	CodePort             = "port"
+	CodeASCII            = "ascii"
+	CodePrintable        = "printable"
+	CodeNoControlChars   = "no_control_chars"
+	CodeAlpha            = "alpha"
+	CodeAlphanumeric     = "alphanumeric"
+	CodeScripts          = "scripts"
+	CodeUTF8             = "utf8"
+	CodeNoInvisible      = "no_invisible"
+	CodeNoMixedScripts   = "no_mixed_scripts"
+	CodePasswordLength   = "password_length"
+	CodePasswordClasses  = "password_classes"
+	CodePasswordEntropy  = "password_entropy"
+	CodePasswordRepeats  = "password_repeats"
+	CodePasswordSequence = "password_sequence"
+	CodePasswordFields   = "password_fields"
+	CodePasswordBreached = "password_breached"
+	CodeFinite           = "finite"
+	CodeNotNaN           = "not_nan"
+	CodeMaxDecimalPlaces = "max_decimal_places"
+	CodePrecision        = "precision"
+	CodeMultipleOf       = "multiple_of"
+	CodeLatitude         = "latitude"
+	CodeLongitude        = "longitude"
+	CodeGeoJSON          = "geojson"
+	CodeGeoJSONRing      = "geojson_ring"
+	CodeGeoJSONWinding   = "geojson_winding"
+	CodeGeoJSONBounds    = "geojson_bounds"
+	CodeCountryAlpha2    = "country_alpha2"
+	CodeCountryAlpha3    = "country_alpha3"
+	CodeCountryNumeric   = "country_numeric"
+	CodeSubdivision      = "subdivision"
+	CodeLanguageTag      = "language_tag"
+	CodeLanguageAlpha2   = "language_alpha2"
+	CodeLanguageAlpha3   = "language_alpha3"
+	CodeCurrency         = "currency"
+	CodeMoneyMinorUnits  = "money_minor_units"
+	CodeMoneyMin         = "money_min"
+	CodeMoneyMax         = "money_max"
+	CodeScale            = "scale"
+	CodeStep             = "step"
+	CodeEven             = "even"
+	CodeOdd              = "odd"
+	CodePowerOfTwo       = "power_of_two"
+	CodeFlags            = "flags"
+	CodeEqApprox         = "eq_approx"
+	CodeRFC3339          = "rfc3339"
+	CodeDate             = "date"
+	CodeLayout           = "layout"
+	CodeTimezone         = "timezone"
+	CodeBefore           = "before"
+	CodeAfter            = "after"
+	CodeJSON             = "json"
+	CodeBase64           = "base64"
+	CodeHex              = "hex"
+	CodeDecodedLen       = "decoded_len"
+	CodeSemVer           = "semver"
+	CodeSemVerRange      = "semver_range"
+	CodeCron             = "cron"
+	CodeLuhn             = "luhn"
+	CodeCreditCard       = "credit_card"
+	CodeIBAN             = "iban"
+	CodeISBN             = "isbn"
+	CodeEAN              = "ean"
+	CodeUPC              = "upc"
+	CodeE164             = "e164"
+	CodeSkeleton         = "skeleton"
+	// CodeTagNotFound is the code of issues for a tag of a Struct that no field of the struct has.
+	CodeTagNotFound = "tag_not_found"
+	// CodeCycle is the code of issues for a struct pointer that (directly or indirectly) points back to itself.
+	CodeCycle = "cycle"
+	// CodeMaxDepth is the code of issues for data nested deeper than a Lazy schema's MaxDepth.
+	CodeMaxDepth = "max_depth"
+	// CodeInvalidSchema is the code of the issue Validate returns for a schema that is invalid (see Struct.Err).
+	// Its Cause wraps ErrInvalidSchema.
+	CodeInvalidSchema = "invalid_schema"
+	// CodeAborted is the code of the issue Validate returns for any other error that stopped validation, such as
+	// one returned by a CustomCtx rule, which is its Cause. ValidateContext returns such errors separately.
+	CodeAborted = "aborted"
+)
@@ -0,0 +1,264 @@
+package z
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	// Code is the currency's alphabetic code (e.g. "USD").
+	Code string
+	// Number is the currency's numeric code (e.g. "840").
+	Number string
+	// MinorUnits is the number of decimal places amounts of the currency are given in (e.g. 2 for USD, 0 for JPY).
+	MinorUnits int
+}
+
+//go:embed data/iso4217.csv
+var iso4217 string
+
+var (
+	currenciesOnce sync.Once
+	currencies     []Currency
+	currencyCodes  map[string]Currency
+)
+
+func loadCurrencies() {
+	currenciesOnce.Do(func() {
+		currencyCodes = make(map[string]Currency)
+		for _, row := range readTable("ISO 4217", iso4217, 3) {
+			minorUnits, err := strconv.Atoi(row[2])
+			if err != nil {
+				panic("z: malformed ISO 4217 table: " + strings.Join(row, ","))
+			}
+			currency := Currency{Code: row[0], Number: row[1], MinorUnits: minorUnits}
+			currencies = append(currencies, currency)
+			currencyCodes[currency.Code] = currency
+		}
+	})
+}
+
+// Currencies returns the ISO 4217 currencies in circulation, sorted by code.
+func Currencies() []Currency {
+	loadCurrencies()
+	return append([]Currency(nil), currencies...)
+}
+
+// LookupCurrency returns the ISO 4217 currency with the (uppercase) alphabetic code, if there is one in circulation.
+func LookupCurrency(code string) (Currency, bool) {
+	loadCurrencies()
+	currency, ok := currencyCodes[code]
+	return currency, ok
+}
+
+// Currency appends a rule validating that data is the (uppercase) alphabetic code of an ISO 4217 currency in
+// circulation (e.g. "USD"). See Currencies.
+func (v *ValidatableString) Currency(msg ...string) *ValidatableString {
+	v.requirement(CodeCurrency, "Currency", func(v *ValidatableString) bool {
+		_, ok := LookupCurrency(v.value)
+		return ok
+	}, msg)
+	return v
+}
+
+var _ ContextValidatable = (*ValidatableMoney)(nil)
+
+// ValidatableMoney is an amount of money that can be validated against the currency it's in, which is given
+// by another field of the struct being validated. Amounts are compared exactly, without floating-point error.
+type ValidatableMoney struct {
+	n number
+	// currencyTag is the tag of the field holding the amount's currency code.
+	currencyTag string
+}
+
+// Validate validates an amount of money against its schema. As the amount's currency is given by another field,
+// a ValidatableMoney should be validated as part of a Struct.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err)
+//	=> data is not a decimal string, json.Number or (non-nil) *big.Rat
+//	=> data fails any of the schema's rules
+func (v *ValidatableMoney) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates an amount of money against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableMoney) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+func (v *ValidatableMoney) walk(r *run, data any, tag ...string) Errors {
+	return v.n.walk(r, data, tag...)
+}
+
+// Err returns the errors hit while building the schema, such as a limit that isn't a decimal, joined together.
+func (v *ValidatableMoney) Err() error { return v.n.err() }
+
+// Optional marks the amount as optional. Calling Validate with nil or a nil pointer will skip validation.
+func (v *ValidatableMoney) Optional() *ValidatableMoney {
+	v.n.optional = true
+	return v
+}
+
+// FailFast stops validating the amount at its first failing rule, rather than running every rule.
+func (v *ValidatableMoney) FailFast() *ValidatableMoney {
+	v.n.failFast = true
+	return v
+}
+
+// Catch marks the amount with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// string, json.Number or *big.Rat pointer (or a struct field validated through a struct pointer), the fallback is
+// written into it. The swallowed issues are recorded in warnings, if provided. A fallback that isn't a decimal
+// makes the schema invalid.
+func (v *ValidatableMoney) Catch(value string, warnings ...*Warnings) *ValidatableMoney {
+	amount, _, ok := parseDecimal(value)
+	if !ok {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: Catch: %q is not a decimal", ErrInvalidSchema, value))
+		return v
+	}
+	v.n.setCatch(value, warnings, func(data any) {
+		if target, ok := data.(*big.Rat); ok && target != nil {
+			target.Set(amount)
+		}
+	})
+	return v
+}
+
+func (v *ValidatableMoney) writes() bool { return v.n.catch != nil }
+
+// currency returns the currency the amount being validated by n is in. Reports false if the currency field doesn't
+// hold the code of a known currency, which is left for the field's own schema (e.g. z.String().Currency()) to report.
+func (v *ValidatableMoney) currency(n *number) (Currency, bool) {
+	if _, exists := n.run.fields[v.currencyTag]; !exists {
+		n.run.fail(fmt.Errorf("%w: Money(%s): tag <%s> not found", ErrInvalidSchema, v.currencyTag, v.currencyTag))
+		return Currency{}, false
+	}
+	field, ok := n.run.field(v.currencyTag)
+	if !ok {
+		return Currency{}, false
+	}
+	code, ok := field.(string)
+	if !ok {
+		return Currency{}, false
+	}
+	return LookupCurrency(code)
+}
+
+// Min appends a rule validating that amounts in currency are at least min. (data >= min)
+func (v *ValidatableMoney) Min(currency, min string, msg ...string) *ValidatableMoney {
+	return v.limit(CodeMoneyMin, "Min", currency, min, func(cmp int) bool { return cmp >= 0 }, msg)
+}
+
+// Max appends a rule validating that amounts in currency are at most max. (data <= max)
+func (v *ValidatableMoney) Max(currency, max string, msg ...string) *ValidatableMoney {
+	return v.limit(CodeMoneyMax, "Max", currency, max, func(cmp int) bool { return cmp <= 0 }, msg)
+}
+
+func (v *ValidatableMoney) limit(code, kind, currency, amount string, accepted func(cmp int) bool, msg []string) *ValidatableMoney {
+	name := kind + "(" + currency + " " + amount + ")"
+	if _, ok := LookupCurrency(currency); !ok {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: %s: unknown currency %q", ErrInvalidSchema, name, currency))
+		return v
+	}
+	bound, _, ok := parseDecimal(amount)
+	if !ok {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: %s: %q is not a decimal", ErrInvalidSchema, name, amount))
+		return v
+	}
+	v.n.rules = append(v.n.rules, func(n *number) rule {
+		return rule{code: code, check: func() string {
+			c, ok := v.currency(n)
+			switch {
+			case !ok || c.Code != currency || accepted(n.value.Cmp(bound)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <money> validation for <%s>", *n.tag, name)
+			default:
+				return fmt.Sprintf("failed <money> validation for <%s>", name)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"currency": currency, "limit": amount}
+		}}
+	})
+	return v
+}
+
+// Custom appends a custom rule to the schema. Validates if the provided function returns true when passed the
+// amount and its currency. The function must not modify the amount.
+func (v *ValidatableMoney) Custom(fn func(amount *big.Rat, currency Currency) bool, msg ...string) *ValidatableMoney {
+	v.n.custom(func(n *number) bool {
+		currency, ok := v.currency(n)
+		return !ok || fn(n.value, currency)
+	}, msg)
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context, the
+// amount and its currency. The function fails validation by returning ErrInvalid (or an error wrapping it); any
+// other error stops validation and is returned separately by ValidateContext. The function must not modify the amount.
+func (v *ValidatableMoney) CustomCtx(fn func(ctx context.Context, amount *big.Rat, currency Currency) error, msg ...string) *ValidatableMoney {
+	v.n.customCtx(func(ctx context.Context, n *number) error {
+		currency, ok := v.currency(n)
+		if !ok {
+			return nil
+		}
+		return fn(ctx, n.value, currency)
+	}, msg)
+	return v
+}
+
+// Money returns a ValidatableMoney for validating an amount of money (a decimal string, json.Number or *big.Rat)
+// in the currency whose ISO 4217 code is held by the field tagged currencyTag, as part of a Struct. Amounts may
+// have no more decimal places than the currency's minor units (e.g. 2 for USD, 0 for JPY, 3 for KWD), judged by
+// their exact value: "10.0" and "1e1" JPY pass like a *big.Rat of 10 does, while "10.5" JPY doesn't.
+// Amounts whose currency isn't known aren't checked, leaving it to the currency field's schema to report.
+//
+//	z.Struct{
+//		"amount":   z.Money("currency").Min("USD", "0.50"),
+//		"currency": z.String().Currency(),
+//	}
+func Money(currencyTag string) *ValidatableMoney {
+	v := &ValidatableMoney{n: number{kind: "money", parse: parseMoney}, currencyTag: currencyTag}
+	v.n.rules = append(v.n.rules, func(n *number) rule {
+		var currency Currency
+		return rule{code: CodeMoneyMinorUnits, check: func() string {
+			var ok bool
+			currency, ok = v.currency(n)
+			// judged by the amount's exact value, so trailing zeros (as in "10.00") don't count
+			scale := ratDigits(n.value).scale
+			switch {
+			case !ok || (scale >= 0 && scale <= currency.MinorUnits):
+				return ""
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <money> validation for <MinorUnits(%s)>", *n.tag, currency.Code)
+			default:
+				return fmt.Sprintf("failed <money> validation for <MinorUnits(%s)>", currency.Code)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"currency": currency.Code, "minor_units": currency.MinorUnits}
+		}}
+	})
+	return v
+}
+
+func parseMoney(data any) (*big.Rat, numberDigits, bool) {
+	switch data.(type) {
+	case string, *string, json.Number:
+		return parseDecimalData(data)
+	case *big.Rat:
+		return parseBigRat(data)
+	default:
+		return nil, numberDigits{}, false
+	}
+}
@@ -0,0 +1,38 @@
+package z
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Minor units are judged by an amount's exact value, however it's written.
+func TestMoneyMinorUnits(t *testing.T) {
+	tests := []struct {
+		amount   any
+		currency string
+		ok       bool
+	}{
+		{"10", "JPY", true},
+		{"10.0", "JPY", true},
+		{"1e1", "JPY", true},
+		{big.NewRat(10, 1), "JPY", true},
+		{"10.5", "JPY", false},
+		{big.NewRat(21, 2), "JPY", false},
+		{"10.50", "USD", true},
+		{"10.500", "USD", true},
+		{"10.505", "USD", false},
+		{big.NewRat(1, 3), "USD", false},
+		{"1.234", "KWD", true},
+		{"1.2345", "KWD", false},
+	}
+	schema := Struct{"amount": Money("currency"), "currency": String()}
+	for _, tt := range tests {
+		errs := schema.Validate(struct {
+			Amount   any    `z:"amount"`
+			Currency string `z:"currency"`
+		}{tt.amount, tt.currency})
+		if ok := errs == nil; ok != tt.ok {
+			t.Errorf("%v %s: got %v, want ok = %v", tt.amount, tt.currency, errs, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+var _ ContextValidatable = (*ValidatableDecimal)(nil)
+
+// ValidatableDecimal is a string holding a decimal number (e.g. "-12.50", "1.5e3") that can be validated,
+// such as an amount of money. Values are compared exactly, without floating-point error.
+type ValidatableDecimal struct {
+	n number
+}
+
+// Validate validates a decimal string, or a json.Number, against its schema.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err)
+//	=> data is not a string or json.Number holding a decimal number
+//	=> data fails any of the schema's rules
+func (v *ValidatableDecimal) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a decimal string, or a json.Number, against its schema, passing ctx to
+// context-aware rules. Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableDecimal) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+func (v *ValidatableDecimal) walk(r *run, data any, tag ...string) Errors {
+	return v.n.walk(r, data, tag...)
+}
+
+// Err returns the errors hit while building the schema, such as a bound that isn't a decimal, joined together.
+func (v *ValidatableDecimal) Err() error { return v.n.err() }
+
+// Optional marks the decimal as optional. Calling Validate with nil or a nil string pointer will skip validation.
+func (v *ValidatableDecimal) Optional() *ValidatableDecimal {
+	v.n.optional = true
+	return v
+}
+
+// FailFast stops validating the decimal at its first failing rule, rather than running every rule.
+func (v *ValidatableDecimal) FailFast() *ValidatableDecimal {
+	v.n.failFast = true
+	return v
+}
+
+// Catch marks the decimal with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// string or json.Number pointer (or a struct field validated through a struct pointer), the fallback is written
+// into it as given. The swallowed issues are recorded in warnings, if provided. A fallback that isn't a decimal
+// makes the schema invalid.
+func (v *ValidatableDecimal) Catch(value string, warnings ...*Warnings) *ValidatableDecimal {
+	if _, _, ok := parseDecimal(value); !ok {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: Catch: %q is not a decimal", ErrInvalidSchema, value))
+		return v
+	}
+	v.n.setCatch(value, warnings, nil)
+	return v
+}
+
+func (v *ValidatableDecimal) writes() bool { return v.n.catch != nil }
+
+// Lt appends a rule validating that data is less than the provided max. (data < max)
+func (v *ValidatableDecimal) Lt(max string, msg ...string) *ValidatableDecimal {
+	v.n.lt(v.bound(max), max, msg)
+	return v
+}
+
+// Gt appends a rule validating that data is greater than the provided min. (data > min)
+func (v *ValidatableDecimal) Gt(min string, msg ...string) *ValidatableDecimal {
+	v.n.gt(v.bound(min), min, msg)
+	return v
+}
+
+// Lte appends a rule validating that data is less than or equal to the provided max. (data <= max)
+func (v *ValidatableDecimal) Lte(max string, msg ...string) *ValidatableDecimal {
+	v.n.lte(v.bound(max), max, msg)
+	return v
+}
+
+// Gte appends a rule validating that data is greater than or equal to the provided min. (data >= min)
+func (v *ValidatableDecimal) Gte(min string, msg ...string) *ValidatableDecimal {
+	v.n.gte(v.bound(min), min, msg)
+	return v
+}
+
+// Range appends a rule validating that data is within the provided range. (min <= data <= max)
+func (v *ValidatableDecimal) Range(min, max string, msg ...string) *ValidatableDecimal {
+	v.n.between(v.bound(min), v.bound(max), min, max, msg)
+	return v
+}
+
+// Eq appends a rule validating that data is equal to the provided value. (data == to)
+func (v *ValidatableDecimal) Eq(to string, msg ...string) *ValidatableDecimal {
+	v.n.eq(v.bound(to), to, msg)
+	return v
+}
+
+// NotEq appends a rule validating that data is not equal to the provided value. (data != to)
+func (v *ValidatableDecimal) NotEq(to string, msg ...string) *ValidatableDecimal {
+	v.n.notEq(v.bound(to), to, msg)
+	return v
+}
+
+// Positive appends a rule validating that data is greater than zero. (data > 0)
+func (v *ValidatableDecimal) Positive(msg ...string) *ValidatableDecimal {
+	v.n.sign(CodePositive, "Positive", func(sign int) bool { return sign > 0 }, msg)
+	return v
+}
+
+// Negative appends a rule validating that data is less than zero. (data < 0)
+func (v *ValidatableDecimal) Negative(msg ...string) *ValidatableDecimal {
+	v.n.sign(CodeNegative, "Negative", func(sign int) bool { return sign < 0 }, msg)
+	return v
+}
+
+// NonNegative appends a rule validating that data is greater than or equal to zero. (data >= 0)
+func (v *ValidatableDecimal) NonNegative(msg ...string) *ValidatableDecimal {
+	v.n.sign(CodeNonNegative, "NonNegative", func(sign int) bool { return sign >= 0 }, msg)
+	return v
+}
+
+// NonPositive appends a rule validating that data is less than or equal to zero. (data <= 0)
+func (v *ValidatableDecimal) NonPositive(msg ...string) *ValidatableDecimal {
+	v.n.sign(CodeNonPositive, "NonPositive", func(sign int) bool { return sign <= 0 }, msg)
+	return v
+}
+
+// NonZero appends a rule validating that data is not equal to zero. (data != 0)
+func (v *ValidatableDecimal) NonZero(msg ...string) *ValidatableDecimal {
+	v.n.sign(CodeNonZero, "NonZero", func(sign int) bool { return sign != 0 }, msg)
+	return v
+}
+
+// Scale appends a rule validating that data has at most places digits after the decimal point, as written
+// (e.g. "1.50" has 2), as in SQL's NUMERIC(precision, scale).
+func (v *ValidatableDecimal) Scale(places int, msg ...string) *ValidatableDecimal {
+	v.n.scale(places, msg)
+	return v
+}
+
+// Precision appends a rule validating that data has at most digits digits, as written and not counting leading
+// zeros (e.g. "012.50" has 4), as in SQL's NUMERIC(precision, scale).
+func (v *ValidatableDecimal) Precision(digits int, msg ...string) *ValidatableDecimal {
+	v.n.precision(digits, msg)
+	return v
+}
+
+// Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data's value.
+// The function must not modify the value.
+func (v *ValidatableDecimal) Custom(fn func(*big.Rat) bool, msg ...string) *ValidatableDecimal {
+	v.n.custom(func(n *number) bool { return fn(n.value) }, msg)
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data's value.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext. The function must not modify the value.
+func (v *ValidatableDecimal) CustomCtx(fn func(context.Context, *big.Rat) error, msg ...string) *ValidatableDecimal {
+	v.n.customCtx(func(ctx context.Context, n *number) error { return fn(ctx, n.value) }, msg)
+	return v
+}
+
+// Decimal returns a ValidatableDecimal for validating a decimal string.
+func Decimal() *ValidatableDecimal {
+	return &ValidatableDecimal{n: number{kind: "decimal", parse: parseDecimalData}}
+}
+
+func parseDecimalData(data any) (*big.Rat, numberDigits, bool) {
+	switch data := data.(type) {
+	case string:
+		return parseDecimal(data)
+	case *string:
+		if data == nil {
+			return nil, numberDigits{}, true
+		}
+		return parseDecimal(*data)
+	case json.Number:
+		return parseDecimal(string(data))
+	default:
+		return nil, numberDigits{}, false
+	}
+}
+
+// bound parses a bound given to a rule, recording a schema error if it isn't a decimal.
+func (v *ValidatableDecimal) bound(s string) *big.Rat {
+	r, _, ok := parseDecimal(s)
+	if !ok {
+		v.n.errs = append(v.n.errs, fmt.Errorf("%w: %q is not a decimal", ErrInvalidSchema, s))
+		return new(big.Rat)
+	}
+	return r
+}
@@ -1,13 +1,18 @@
 package z
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MarcusSanchez/go-z/internal"
+	"math"
+	"strconv"
+	"strings"
 )
 
 var (
-	_ Validatable = (*ValidatableFloat[float32])(nil)
-	_ Validatable = (*ValidatableFloat[float64])(nil)
+	_ ContextValidatable = (*ValidatableFloat[float32])(nil)
+	_ ContextValidatable = (*ValidatableFloat[float64])(nil)
 )
 
 type floats interface {
@@ -15,11 +20,20 @@ type floats interface {
 }
 
 // ValidatableFloat is a float32 or float64 that can be validated.
+//
+// Rules that depend on a float's decimal digits (e.g. MaxDecimalPlaces, MultipleOf, EqApprox) take a float32
+// to be the shortest decimal that reads back as it, so float32(0.1) is 0.1 rather than the 0.10000000149011612
+// it widens to as a float64. Messages print float32s the same way.
 type ValidatableFloat[T floats] struct {
-	tag      *string
-	value    T
-	rules    []rule
-	optional bool
+	tag   *string
+	value T
+	// rules build the rules of the schema for the copy of it made to validate a value (see walk).
+	rules         []func(v *ValidatableFloat[T]) rule
+	optional      bool
+	failFast      bool
+	normalizeZero bool
+	run           *run
+	fallback      *fallback[T]
 }
 
 // Validate validates a float32 or float64 against its schema.
@@ -28,35 +42,55 @@ type ValidatableFloat[T floats] struct {
 //	=> data is not a float32/float64 or doesn't match the correct generic type
 //	=> data fails any of the schema's rules
 func (v *ValidatableFloat[T]) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a float32 or float64 against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableFloat[T]) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+// walk validates data against a copy of the schema, which holds the value being validated, so the schema can
+// validate any number of values at a time.
+func (v *ValidatableFloat[T]) walk(r *run, data any, tag ...string) Errors {
+	c := *v
+	return c.walkCopy(r, data, tag...)
+}
+
+// walkCopy is walk for the copy of the schema made to validate data.
+func (v *ValidatableFloat[T]) walkCopy(r *run, data any, tag ...string) Errors {
 	if len(tag) > 0 {
 		v.tag = &tag[0]
 	}
-	if v.optional {
-		if data == nil {
+	if v.optional && data == nil {
+		return nil
+	}
+	var target *T
+	if value, ok := data.(*T); ok && (v.optional || v.writes()) {
+		if value == nil && v.optional {
 			return nil
 		}
-		if value, ok := data.(*T); ok {
-			if value == nil {
-				return nil
-			}
+		if value != nil {
+			target = value
 			data = *value
 		}
 	}
 	var ok bool
 	if v.value, ok = data.(T); !ok {
-		if v.tag == nil {
-			return internal.NewValidationErrors(fmt.Sprintf("failed validation for <%T>", v.value))
-		}
-		return internal.NewValidationErrors(fmt.Sprintf("<%s> failed validation for <%T>", *v.tag, v.value))
+		return r.report(v.fallback.apply(target, typeMismatch(fmt.Sprintf("%T", v.value), v.tag, data)))
 	}
-	vErrors := make([]string, 0, len(v.rules))
-	for _, rule := range v.rules {
-		if err := rule(); err != "" {
-			vErrors = append(vErrors, err)
-		}
+	if v.normalizeZero && v.value == 0 {
+		// -0 == 0, so this turns -0 into 0
+		v.value = 0
 	}
+	v.run = r
+	vErrors := v.run.check(bind(v, v.rules), v.failFast, v.tag)
 	if len(vErrors) > 0 {
-		return internal.NewValidationErrors(vErrors...)
+		return r.report(v.fallback.apply(target, internal.NewValidationIssues(vErrors...)))
+	}
+	if v.normalizeZero && target != nil {
+		*target = v.value
 	}
 	return nil
 }
@@ -67,242 +101,451 @@ func (v *ValidatableFloat[T]) Optional() *ValidatableFloat[T] {
 	return v
 }
 
+// FailFast stops validating the float32 or float64 at its first failing rule, rather than running every rule.
+func (v *ValidatableFloat[T]) FailFast() *ValidatableFloat[T] {
+	v.failFast = true
+	return v
+}
+
+// Catch marks the float32 or float64 with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// float32 or float64 pointer (or a struct field validated through a struct pointer), the fallback is written into it.
+// The swallowed issues are recorded in warnings, if provided.
+func (v *ValidatableFloat[T]) Catch(value T, warnings ...*Warnings) *ValidatableFloat[T] {
+	v.fallback = newFallback(value, warnings)
+	return v
+}
+
+// NormalizeZero turns -0 into 0 before any rule is run. If data is a float32 or float64 pointer (or a struct field
+// validated through a struct pointer) and passes validation, the normalized value is written back into it.
+func (v *ValidatableFloat[T]) NormalizeZero() *ValidatableFloat[T] {
+	v.normalizeZero = true
+	return v
+}
+
+func (v *ValidatableFloat[T]) writes() bool { return v.fallback != nil || v.normalizeZero }
+
 // Lt appends a rule validating that data is less than the provided max. (data < max)
 func (v *ValidatableFloat[T]) Lt(max T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value < max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Lt(%g)>", *v.tag, v.value, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Lt(%g)>", v.value, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeLt, check: func() string {
+			switch {
+			case v.value < max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Lt(%g)>", *v.tag, v.value, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Lt(%g)>", v.value, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Gt appends a rule validating that data is greater than the provided min. (data > min)
 func (v *ValidatableFloat[T]) Gt(min T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value > min:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Gt(%g)>", *v.tag, v.value, min)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Gt(%g)>", v.value, min)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeGt, check: func() string {
+			switch {
+			case v.value > min:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Gt(%g)>", *v.tag, v.value, min)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Gt(%g)>", v.value, min)
+			}
+		}}
 	})
 	return v
 }
 
 // Lte appends a rule validating that data is less than or equal to the provided max. (data <= max)
 func (v *ValidatableFloat[T]) Lte(max T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value <= max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Lte(%g)>", *v.tag, v.value, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Lte(%g)>", v.value, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeLte, check: func() string {
+			switch {
+			case v.value <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Lte(%g)>", *v.tag, v.value, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Lte(%g)>", v.value, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Gte appends a rule validating that data is greater than or equal to the provided min. (data >= min)
 func (v *ValidatableFloat[T]) Gte(min T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value >= min:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Gte(%g)>", *v.tag, v.value, min)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Gte(%g)>", v.value, min)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeGte, check: func() string {
+			switch {
+			case v.value >= min:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Gte(%g)>", *v.tag, v.value, min)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Gte(%g)>", v.value, min)
+			}
+		}}
 	})
 	return v
 }
 
 // Range appends a rule validating that data is within the provided range. (min <= data <= max)
 func (v *ValidatableFloat[T]) Range(min, max T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case min <= v.value && v.value <= max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Range(%d, %d)>", *v.tag, v.value, min, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Range(%d, %d)>", v.value, min, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeRange, check: func() string {
+			switch {
+			case min <= v.value && v.value <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Range(%g, %g)>", *v.tag, v.value, min, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Range(%g, %g)>", v.value, min, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Eq appends a rule validating that data is equal to the provided value. (data == to)
+// Equality is exact; to allow for rounding errors, use EqApprox.
 func (v *ValidatableFloat[T]) Eq(to T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value == to:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Eq(%g)>", *v.tag, v.value, to)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Eq(%g)>", v.value, to)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeEq, check: func() string {
+			switch {
+			case v.value == to:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Eq(%g)>", *v.tag, v.value, to)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Eq(%g)>", v.value, to)
+			}
+		}}
 	})
 	return v
 }
 
 // NotEq appends a rule validating that data is not equal to the provided value. (data != to)
+// NaN fails the rule, as it can't be told apart from any value.
 func (v *ValidatableFloat[T]) NotEq(to T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != to:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NotEq(%g)>", *v.tag, v.value, to)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NotEq(%g)>", v.value, to)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNotEq, check: func() string {
+			switch {
+			case v.value != to && !math.IsNaN(float64(v.value)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NotEq(%g)>", *v.tag, v.value, to)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NotEq(%g)>", v.value, to)
+			}
+		}}
 	})
 	return v
 }
 
 // Positive appends a rule validating that data is greater than zero. (data > 0)
 func (v *ValidatableFloat[T]) Positive(msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value > 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Positive>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Positive>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodePositive, check: func() string {
+			switch {
+			case v.value > 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Positive>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Positive>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // Negative appends a rule validating that data is less than zero. (data < 0)
 func (v *ValidatableFloat[T]) Negative(msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value < 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Negative>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Negative>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNegative, check: func() string {
+			switch {
+			case v.value < 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Negative>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Negative>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // NonNegative appends a rule validating that data is greater than or equal to zero. (data >= 0)
 func (v *ValidatableFloat[T]) NonNegative(msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value >= 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NonNegative>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NonNegative>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNonNegative, check: func() string {
+			switch {
+			case v.value >= 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NonNegative>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NonNegative>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // NonPositive appends a rule validating that data is less than or equal to zero. (data <= 0)
 func (v *ValidatableFloat[T]) NonPositive(msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value <= 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NonPositive>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NonPositive>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNonPositive, check: func() string {
+			switch {
+			case v.value <= 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NonPositive>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NonPositive>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // NonZero appends a rule validating that data is not equal to zero. (data != 0)
+// NaN fails the rule, as it isn't a number at all.
 func (v *ValidatableFloat[T]) NonZero(msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NonZero>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NonZero>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNonZero, check: func() string {
+			switch {
+			case v.value != 0 && !math.IsNaN(float64(v.value)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NonZero>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NonZero>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // In appends a rule validating that data is in the provided slice of values.
 func (v *ValidatableFloat[T]) In(values []T, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		for _, value := range values {
-			if v.value == value {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeIn, check: func() string {
+			for _, value := range values {
+				if v.value == value {
+					return ""
+				}
+			}
+			if len(msg) > 0 {
+				return msg[0]
+			}
+			if v.tag != nil {
+				return fmt.Sprintf("<%s> failed <%T> validation for <In(%v)>", *v.tag, v.value, values)
+			}
+			return fmt.Sprintf("failed <%T> validation for <In(%v)>", v.value, values)
+		}}
+	})
+	return v
+}
+
+// Finite appends a rule validating that data is neither NaN nor an infinity.
+func (v *ValidatableFloat[T]) Finite(msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeFinite, check: func() string {
+			switch {
+			case !math.IsNaN(float64(v.value)) && !math.IsInf(float64(v.value), 0):
 				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Finite>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Finite>", v.value)
 			}
-		}
-		if len(msg) > 0 {
-			return msg[0]
-		}
-		if v.tag != nil {
-			return fmt.Sprintf("<%s> failed <%T> validation for <In(%s)>", *v.tag, v.value, values)
-		}
-		return fmt.Sprintf("failed <%T> validation for <In(%s)>", v.value, values)
+		}}
+	})
+	return v
+}
+
+// NotNaN appends a rule validating that data is not NaN. Infinities pass the rule; to reject them too, use Finite.
+func (v *ValidatableFloat[T]) NotNaN(msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeNotNaN, check: func() string {
+			switch {
+			case !math.IsNaN(float64(v.value)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NotNaN>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NotNaN>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// MaxDecimalPlaces appends a rule validating that data has at most places digits after the decimal point
+// (e.g. 12.25 has 2), as written in its shortest decimal form. NaN and infinities fail the rule.
+func (v *ValidatableFloat[T]) MaxDecimalPlaces(places int, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeMaxDecimalPlaces, check: func() string {
+			switch {
+			case isFinite(v.value) && decimalPlaces(v.value) <= places:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <MaxDecimalPlaces(%d)>", *v.tag, v.value, places)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <MaxDecimalPlaces(%d)>", v.value, places)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"places": places}
+		}}
+	})
+	return v
+}
+
+// Precision appends a rule validating that data has at most digits significant digits (e.g. 0.0125 has 3),
+// as written in its shortest decimal form. NaN and infinities fail the rule.
+func (v *ValidatableFloat[T]) Precision(digits int, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodePrecision, check: func() string {
+			switch {
+			case isFinite(v.value) && significantDigits(v.value) <= digits:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Precision(%d)>", *v.tag, v.value, digits)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Precision(%d)>", v.value, digits)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"digits": digits}
+		}}
+	})
+	return v
+}
+
+// MultipleOf appends a rule validating that data is within epsilon of a multiple of step (e.g. 0.3 is a multiple
+// of 0.1, within an epsilon of 1e-9). As few decimals are exact in binary, epsilon should rarely be zero.
+// NaN and infinities fail the rule, as does a step of zero. Issues hold the step and epsilon in their Params under
+// "step" and "epsilon", which are stable; the step can be used as JSON Schema's multipleOf.
+func (v *ValidatableFloat[T]) MultipleOf(step, epsilon T, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeMultipleOf, check: func() string {
+			switch {
+			case isFinite(v.value) && step != 0 && math.Abs(math.Remainder(widen(v.value), widen(step))) <= widen(epsilon):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <MultipleOf(%g, %g)>", *v.tag, v.value, step, epsilon)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <MultipleOf(%g, %g)>", v.value, step, epsilon)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"step": step, "epsilon": epsilon}
+		}}
+	})
+	return v
+}
+
+// EqApprox appends a rule validating that data is within tolerance of the provided value. (|data - to| <= tolerance)
+// NaN fails the rule.
+func (v *ValidatableFloat[T]) EqApprox(to, tolerance T, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeEqApprox, check: func() string {
+			switch {
+			case math.Abs(widen(v.value)-widen(to)) <= widen(tolerance):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <EqApprox(%g, %g)>", *v.tag, v.value, to, tolerance)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <EqApprox(%g, %g)>", v.value, to, tolerance)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"value": to, "tolerance": tolerance}
+		}}
 	})
 	return v
 }
 
 // Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data.
-func (v *ValidatableFloat[T]) Custom(rule func(T) bool, msg ...string) *ValidatableFloat[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case rule(v.value):
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Custom>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Custom>", v.value)
-		}
+func (v *ValidatableFloat[T]) Custom(fn func(T) bool, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeCustom, check: func() string {
+			switch {
+			case fn(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Custom>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Custom>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext.
+func (v *ValidatableFloat[T]) CustomCtx(fn func(context.Context, T) error, msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		var err error
+		return rule{code: CodeCustomCtx, check: func() string {
+			err = fn(v.run.ctx, v.value)
+			switch {
+			case err == nil:
+				return ""
+			case !errors.Is(err, ErrInvalid):
+				v.run.fail(err)
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <CustomCtx>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <CustomCtx>", v.value)
+			}
+		}, cause: func() error { return err }}
 	})
 	return v
 }
@@ -312,3 +555,43 @@ func Float32() *ValidatableFloat[float32] { return &ValidatableFloat[float32]{}
 
 // Float64 returns a ValidatableFloat[float64] for validating an float64.
 func Float64() *ValidatableFloat[float64] { return &ValidatableFloat[float64]{} }
+
+func isFinite[T floats](f T) bool {
+	return !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0)
+}
+
+// bitSize returns the size of T, in bits.
+func bitSize[T floats]() int {
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		return 32
+	}
+	return 64
+}
+
+// widen converts f to a float64. A float32 becomes the float64 closest to its shortest decimal form,
+// rather than its exact value.
+func widen[T floats](f T) float64 {
+	if bitSize[T]() == 64 || !isFinite(f) {
+		return float64(f)
+	}
+	widened, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+	return widened
+}
+
+// decimalPlaces returns the number of digits after the decimal point in the shortest decimal form of f.
+func decimalPlaces[T floats](f T) int {
+	s := strconv.FormatFloat(float64(f), 'f', -1, bitSize[T]())
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		return len(s) - dot - 1
+	}
+	return 0
+}
+
+// significantDigits returns the number of significant digits in the shortest decimal form of f.
+func significantDigits[T floats](f T) int {
+	// in 'e' form, e.g. "-1.25e-02", the significant digits are those of the mantissa
+	s := strconv.FormatFloat(float64(f), 'e', -1, bitSize[T]())
+	mantissa, _, _ := strings.Cut(strings.TrimPrefix(s, "-"), "e")
+	return len(strings.Replace(mantissa, ".", "", 1))
+}
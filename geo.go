@@ -0,0 +1,496 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+	"strconv"
+)
+
+// Latitude appends a rule validating that data is a latitude, in degrees. (-90 <= data <= 90)
+func (v *ValidatableFloat[T]) Latitude(msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeLatitude, check: func() string {
+			switch {
+			case isLatitude(float64(v.value)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Latitude>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Latitude>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// Longitude appends a rule validating that data is a longitude, in degrees. (-180 <= data <= 180)
+func (v *ValidatableFloat[T]) Longitude(msg ...string) *ValidatableFloat[T] {
+	v.rules = append(v.rules, func(v *ValidatableFloat[T]) rule {
+		return rule{code: CodeLongitude, check: func() string {
+			switch {
+			case isLongitude(float64(v.value)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Longitude>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Longitude>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+func isLatitude(f float64) bool  { return -90 <= f && f <= 90 }
+func isLongitude(f float64) bool { return -180 <= f && f <= 180 }
+
+// Coordinates returns a Struct schema for a pair of float64 coordinates, whose latitude and longitude
+// fields are tagged latTag and lngTag.
+//
+//	type Location struct {
+//		Lat float64 `z:"lat"`
+//		Lng float64 `z:"lng"`
+//	}
+//
+//	z.Coordinates("lat", "lng").Validate(location)
+func Coordinates(latTag, lngTag string) Struct {
+	return Struct{
+		latTag: Float64().Latitude(),
+		lngTag: Float64().Longitude(),
+	}
+}
+
+var _ ContextValidatable = (*ValidatableGeoJSON)(nil)
+
+// ValidatableGeoJSON is a GeoJSON object (RFC 7946) that can be validated: a geometry (Point, MultiPoint,
+// LineString, MultiLineString, Polygon, MultiPolygon or GeometryCollection), a Feature, or a FeatureCollection.
+//
+// Positions must have a longitude and latitude within range (and optionally an altitude), line strings at least
+// two positions, and polygon rings at least four positions, the last the same as the first. Issues are reported
+// with paths into the object (e.g. "<area.coordinates[0][3]>").
+type ValidatableGeoJSON struct {
+	optional  bool
+	types     []string
+	rightHand bool
+	bounds    *[4]float64
+	failFast  bool
+	fallback  *fallback[string]
+}
+
+// Validate validates GeoJSON against its schema. Data may be JSON text (a string, []byte or json.RawMessage),
+// or a map[string]any holding the decoded object.
+//
+//	Returns Errors if:
+//	=> data is not GeoJSON text or a decoded GeoJSON object
+//	=> data is not valid GeoJSON, or fails any of the schema's rules
+func (v *ValidatableGeoJSON) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates GeoJSON against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableGeoJSON) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+func (v *ValidatableGeoJSON) walk(r *run, data any, tag ...string) Errors {
+	if v.optional && isNil(data) {
+		return nil
+	}
+	errs := v.check(data, tag...)
+	if errs == nil || v.fallback == nil {
+		return r.report(errs)
+	}
+	// the fallback is written into whichever kind of text data points to
+	switch target := data.(type) {
+	case *string:
+		if target != nil {
+			*target = v.fallback.value
+		}
+	case *[]byte:
+		if target != nil {
+			*target = []byte(v.fallback.value)
+		}
+	case *json.RawMessage:
+		if target != nil {
+			*target = json.RawMessage(v.fallback.value)
+		}
+	}
+	return r.report(v.fallback.apply(nil, errs))
+}
+
+// check validates data, returning the issues found in it.
+func (v *ValidatableGeoJSON) check(data any, tag ...string) Errors {
+	c := &geoChecker{schema: v}
+	if len(tag) > 0 {
+		c.tag = tag[0]
+	}
+
+	var text []byte
+	switch data := data.(type) {
+	case string:
+		text = []byte(data)
+	case *string:
+		if data != nil {
+			text = []byte(*data)
+		}
+	case []byte:
+		text = data
+	case *[]byte:
+		if data != nil {
+			text = *data
+		}
+	case json.RawMessage:
+		text = data
+	case *json.RawMessage:
+		if data != nil {
+			text = *data
+		}
+	case map[string]any:
+		c.object(c.tag, data, v.types)
+		return c.errors()
+	}
+	if text == nil {
+		return typeMismatch("GeoJSON", tagOf(tag), data)
+	}
+	var object any
+	if err := json.Unmarshal(text, &object); err != nil {
+		detail, params := jsonDetail(string(text), err)
+		c.fail(CodeJSON, c.tag, "JSON", detail, params)
+		return c.errors()
+	}
+	obj, ok := object.(map[string]any)
+	if !ok {
+		c.fail(CodeGeoJSON, c.tag, "GeoJSON", "not an object", nil)
+		return c.errors()
+	}
+	c.object(c.tag, obj, v.types)
+	return c.errors()
+}
+
+// Optional marks the GeoJSON as optional. Calling Validate with nil or a nil pointer will skip validation.
+func (v *ValidatableGeoJSON) Optional() *ValidatableGeoJSON {
+	v.optional = true
+	return v
+}
+
+// Types restricts the GeoJSON object to the provided types (e.g. "Polygon", "MultiPolygon"). Geometries
+// nested within it (e.g. in a Feature) may still be of any type.
+func (v *ValidatableGeoJSON) Types(types ...string) *ValidatableGeoJSON {
+	v.types = types
+	return v
+}
+
+// RightHandRule requires polygon rings to follow the right-hand rule, which RFC 7946 recommends but doesn't require:
+// exterior rings counterclockwise, and holes clockwise.
+func (v *ValidatableGeoJSON) RightHandRule() *ValidatableGeoJSON {
+	v.rightHand = true
+	return v
+}
+
+// Within requires every position to be within the bounding box from (west, south) to (east, north), in degrees.
+// A box crossing the antimeridian has a west greater than its east.
+func (v *ValidatableGeoJSON) Within(west, south, east, north float64) *ValidatableGeoJSON {
+	v.bounds = &[4]float64{west, south, east, north}
+	return v
+}
+
+// FailFast stops validating the GeoJSON at its first issue, rather than reporting every issue in the object.
+func (v *ValidatableGeoJSON) FailFast() *ValidatableGeoJSON {
+	v.failFast = true
+	return v
+}
+
+// Catch marks the GeoJSON with a fallback value, given as JSON text. If validation fails, Validate returns no
+// Errors and, if data is a string, []byte or json.RawMessage pointer (or a struct field validated through a struct
+// pointer), the fallback is written into it. The swallowed issues are recorded in warnings, if provided.
+func (v *ValidatableGeoJSON) Catch(value string, warnings ...*Warnings) *ValidatableGeoJSON {
+	v.fallback = newFallback(value, warnings)
+	return v
+}
+
+func (v *ValidatableGeoJSON) writes() bool { return v.fallback != nil }
+
+// GeoJSON returns a ValidatableGeoJSON for validating a GeoJSON object.
+func GeoJSON() *ValidatableGeoJSON { return &ValidatableGeoJSON{} }
+
+// geoChecker collects the issues found in a GeoJSON object.
+type geoChecker struct {
+	schema *ValidatableGeoJSON
+	tag    string
+	issues []Issue
+}
+
+func (c *geoChecker) errors() Errors {
+	if len(c.issues) == 0 {
+		return nil
+	}
+	return internal.NewValidationIssues(c.issues...)
+}
+
+// fail records an issue at path, found validating a GeoJSON object of type typ.
+func (c *geoChecker) fail(code, path, typ, detail string, params map[string]any) {
+	if c.schema.failFast && len(c.issues) > 0 {
+		return
+	}
+	message := fmt.Sprintf("failed <GeoJSON> validation for <%s> (%s)", typ, detail)
+	if path != "" {
+		message = "<" + path + "> " + message
+	}
+	c.issues = append(c.issues, Issue{Code: code, Path: path, Message: message, Params: params})
+}
+
+func member(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func element(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+var geometryTypes = []string{"Point", "MultiPoint", "LineString", "MultiLineString", "Polygon", "MultiPolygon", "GeometryCollection"}
+
+// object validates a GeoJSON object at path, which must be one of types (if any are given).
+func (c *geoChecker) object(path string, obj map[string]any, types []string) {
+	typ, ok := obj["type"].(string)
+	if !ok {
+		c.fail(CodeGeoJSON, member(path, "type"), "GeoJSON", "missing type", nil)
+		return
+	}
+	if len(types) > 0 && !contains(types, typ) {
+		c.fail(CodeGeoJSON, member(path, "type"), typ, fmt.Sprintf("type must be one of %v", types), map[string]any{"types": types})
+		return
+	}
+	if bbox, ok := obj["bbox"]; ok {
+		c.bbox(member(path, "bbox"), typ, bbox)
+	}
+
+	switch typ {
+	case "Feature":
+		geometry, exists := obj["geometry"]
+		switch geometry := geometry.(type) {
+		case nil:
+			if !exists {
+				c.fail(CodeGeoJSON, member(path, "geometry"), typ, "missing geometry", nil)
+			}
+		case map[string]any:
+			c.object(member(path, "geometry"), geometry, geometryTypes)
+		default:
+			c.fail(CodeGeoJSON, member(path, "geometry"), typ, "geometry must be an object or null", nil)
+		}
+		if properties, exists := obj["properties"]; !exists {
+			c.fail(CodeGeoJSON, member(path, "properties"), typ, "missing properties", nil)
+		} else if _, ok := properties.(map[string]any); properties != nil && !ok {
+			c.fail(CodeGeoJSON, member(path, "properties"), typ, "properties must be an object or null", nil)
+		}
+	case "FeatureCollection":
+		c.collection(path, typ, obj, "features", []string{"Feature"})
+	case "GeometryCollection":
+		c.collection(path, typ, obj, "geometries", geometryTypes)
+	case "Point", "MultiPoint", "LineString", "MultiLineString", "Polygon", "MultiPolygon":
+		coordinates, exists := obj["coordinates"]
+		if !exists {
+			c.fail(CodeGeoJSON, member(path, "coordinates"), typ, "missing coordinates", nil)
+			return
+		}
+		c.coordinates(member(path, "coordinates"), typ, coordinates)
+	default:
+		c.fail(CodeGeoJSON, member(path, "type"), typ, fmt.Sprintf("unknown type %q", typ), nil)
+	}
+}
+
+// collection validates the members (features or geometries) of a collection, which must be one of types.
+func (c *geoChecker) collection(path, typ string, obj map[string]any, key string, types []string) {
+	members, ok := obj[key].([]any)
+	if !ok {
+		c.fail(CodeGeoJSON, member(path, key), typ, "missing "+key+" array", nil)
+		return
+	}
+	for i, m := range members {
+		m, ok := m.(map[string]any)
+		if !ok {
+			c.fail(CodeGeoJSON, element(member(path, key), i), typ, "not an object", nil)
+			continue
+		}
+		c.object(element(member(path, key), i), m, types)
+	}
+}
+
+// coordinates validates the coordinates of a geometry of type typ.
+func (c *geoChecker) coordinates(path, typ string, coordinates any) {
+	switch typ {
+	case "Point":
+		c.position(path, typ, coordinates)
+	case "MultiPoint":
+		c.positions(path, typ, coordinates, 0)
+	case "LineString":
+		c.positions(path, typ, coordinates, 2)
+	case "MultiLineString":
+		for i, line := range c.array(path, typ, coordinates, 0) {
+			c.positions(element(path, i), typ, line, 2)
+		}
+	case "Polygon":
+		c.polygon(path, typ, coordinates)
+	case "MultiPolygon":
+		for i, polygon := range c.array(path, typ, coordinates, 0) {
+			c.polygon(element(path, i), typ, polygon)
+		}
+	}
+}
+
+// array returns value as an array of at least min elements, or nil (recording an issue) if it isn't one.
+func (c *geoChecker) array(path, typ string, value any, min int) []any {
+	array, ok := value.([]any)
+	switch {
+	case !ok:
+		c.fail(CodeGeoJSON, path, typ, "not an array", nil)
+		return nil
+	case len(array) < min:
+		c.fail(CodeGeoJSON, path, typ, fmt.Sprintf("needs at least %d positions, has %d", min, len(array)), map[string]any{"min": min})
+		return nil
+	}
+	return array
+}
+
+// position validates a position, returning its longitude and latitude (or nil, if it isn't valid).
+func (c *geoChecker) position(path, typ string, value any) []float64 {
+	array, ok := value.([]any)
+	if !ok || len(array) < 2 || len(array) > 3 {
+		c.fail(CodeGeoJSON, path, typ, "position must be an array of 2 or 3 numbers", nil)
+		return nil
+	}
+	position := make([]float64, len(array))
+	for i, n := range array {
+		if position[i], ok = n.(float64); !ok {
+			c.fail(CodeGeoJSON, element(path, i), typ, "not a number", nil)
+			return nil
+		}
+	}
+	lng, lat := position[0], position[1]
+	valid := true
+	if !isLongitude(lng) {
+		c.fail(CodeLongitude, element(path, 0), typ, fmt.Sprintf("longitude %g out of range", lng), map[string]any{"longitude": lng})
+		valid = false
+	}
+	if !isLatitude(lat) {
+		c.fail(CodeLatitude, element(path, 1), typ, fmt.Sprintf("latitude %g out of range", lat), map[string]any{"latitude": lat})
+		valid = false
+	}
+	if valid && c.schema.bounds != nil && !withinBounds(*c.schema.bounds, lng, lat) {
+		c.fail(CodeGeoJSONBounds, path, typ, "position out of bounds", map[string]any{"bounds": c.schema.bounds[:]})
+	}
+	if !valid {
+		return nil
+	}
+	return position
+}
+
+// positions validates an array of at least min positions, returning them (or nil, if any isn't valid).
+func (c *geoChecker) positions(path, typ string, value any, min int) [][]float64 {
+	array := c.array(path, typ, value, min)
+	if array == nil {
+		return nil
+	}
+	positions := make([][]float64, len(array))
+	valid := true
+	for i, p := range array {
+		if positions[i] = c.position(element(path, i), typ, p); positions[i] == nil {
+			valid = false
+		}
+	}
+	if !valid {
+		return nil
+	}
+	return positions
+}
+
+// polygon validates the rings of a polygon, the first of which is its exterior and the rest its holes.
+func (c *geoChecker) polygon(path, typ string, value any) {
+	for i, ring := range c.array(path, typ, value, 0) {
+		positions := c.positions(element(path, i), typ, ring, 4)
+		if positions == nil {
+			continue
+		}
+		first, last := positions[0], positions[len(positions)-1]
+		if !equalPositions(first, last) {
+			c.fail(CodeGeoJSONRing, element(element(path, i), len(positions)-1), typ, "ring is not closed", nil)
+			continue
+		}
+		if !c.schema.rightHand {
+			continue
+		}
+		// the shoelace formula gives a positive area for counterclockwise rings, and a negative area for clockwise ones
+		area := 0.0
+		for j := 0; j < len(positions)-1; j++ {
+			area += positions[j][0]*positions[j+1][1] - positions[j+1][0]*positions[j][1]
+		}
+		if exterior := i == 0; exterior && area < 0 {
+			c.fail(CodeGeoJSONWinding, element(path, i), typ, "exterior ring must be counterclockwise", nil)
+		} else if !exterior && area > 0 {
+			c.fail(CodeGeoJSONWinding, element(path, i), typ, "hole must be clockwise", nil)
+		}
+	}
+}
+
+// bbox validates a bounding box: west, south, east and north, optionally with the lowest and highest altitude
+// after the south and north.
+func (c *geoChecker) bbox(path, typ string, value any) {
+	array, ok := value.([]any)
+	if !ok || (len(array) != 4 && len(array) != 6) {
+		c.fail(CodeGeoJSON, path, typ, "bbox must be an array of 4 or 6 numbers", nil)
+		return
+	}
+	box := make([]float64, len(array))
+	for i, n := range array {
+		if box[i], ok = n.(float64); !ok {
+			c.fail(CodeGeoJSON, element(path, i), typ, "not a number", nil)
+			return
+		}
+	}
+	half := len(box) / 2
+	west, south, east, north := box[0], box[1], box[half], box[half+1]
+	if !isLongitude(west) || !isLongitude(east) || !isLatitude(south) || !isLatitude(north) || south > north {
+		c.fail(CodeGeoJSON, path, typ, "bbox out of range", nil)
+	}
+}
+
+func equalPositions(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func withinBounds(bounds [4]float64, lng, lat float64) bool {
+	west, south, east, north := bounds[0], bounds[1], bounds[2], bounds[3]
+	if lat < south || lat > north {
+		return false
+	}
+	if west <= east {
+		return west <= lng && lng <= east
+	}
+	// the box crosses the antimeridian
+	return lng >= west || lng <= east
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,3 +2,4 @@ module github.com/MarcusSanchez/go-z
 
 go 1.20
 
+require golang.org/x/text v0.14.0
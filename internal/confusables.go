@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Skeleton approximates the skeleton of s, as described by Unicode Technical Standard #39: two strings are
+// confusable (visually alike) if their skeletons are equal. Unlike the standard's confusables.txt, only the
+// confusables most often used to spoof Latin identifiers are mapped: Cyrillic and Greek look-alikes, fullwidth forms, and digits and letters that
+// look alike in common fonts. Invisible characters are dropped.
+func Skeleton(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case IsInvisible(r):
+		case 0xFF01 <= r && r <= 0xFF5E:
+			// fullwidth forms of ASCII
+			b.WriteString(prototype(r - 0xFF01 + '!'))
+		default:
+			b.WriteString(prototype(r))
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+func prototype(r rune) string {
+	if p, ok := confusables[r]; ok {
+		return p
+	}
+	return string(r)
+}
+
+// IsInvisible reports whether r is rendered without a glyph: format characters (zero width spaces and
+// joiners, bidirectional controls, soft hyphens, ...), variation selectors and fillers.
+func IsInvisible(r rune) bool {
+	return unicode.Is(unicode.Cf, r) ||
+		r == 0x034F || // combining grapheme joiner
+		r == 0x115F || r == 0x1160 || r == 0x3164 || r == 0xFFA0 || // Hangul fillers
+		r == 0x17B4 || r == 0x17B5 || // Khmer inherent vowels
+		0x180B <= r && r <= 0x180F || // Mongolian variation selectors
+		0xFE00 <= r && r <= 0xFE0F || 0xE0100 <= r && r <= 0xE01EF // variation selectors
+}
+
+var confusables = map[rune]string{
+	// ASCII look-alikes
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'm': "rn",
+	// Cyrillic
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x", 'ѕ': "s", 'і': "i",
+	'ј': "j", 'ԁ': "d", 'һ': "h", 'ԛ': "q", 'ԝ': "w", 'ӏ': "l", 'ү': "y",
+	'А': "A", 'В': "B", 'Е': "E", 'К': "K", 'М': "M", 'Н': "H", 'О': "O", 'Р': "P", 'С': "C", 'Т': "T",
+	'Х': "X", 'Ѕ': "S", 'І': "l", 'Ј': "J", 'Ү': "Y", 'Ԛ': "Q", 'Ԝ': "W", 'Ӏ': "l",
+	// Greek
+	'α': "a", 'ο': "o", 'ν': "v", 'ρ': "p", 'ι': "i", 'κ': "k", 'υ': "u", 'ϲ': "c", 'ϳ': "j",
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "l", 'Κ': "K", 'Μ': "M", 'Ν': "N", 'Ο': "O",
+	'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X", 'Ϲ': "C",
+	// Latin letters that read as others
+	'ı': "i", 'ȷ': "j", 'ℓ': "l", 'ɑ': "a", 'ɩ': "i", 'ʏ': "y", 'ɡ': "g",
+}
@@ -0,0 +1,49 @@
+package internal
+
+// Distance returns the optimal string alignment distance between a and b: the number of rune insertions,
+// deletions, substitutions, and transpositions of adjacent runes needed to turn one into the other.
+func Distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
+
+// Closest returns the candidate closest to s, if it's within maxDistance of s. Ties go to the earliest candidate.
+func Closest(s string, candidates []string, maxDistance int) (string, bool) {
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if d := Distance(s, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best, bestDistance <= maxDistance
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
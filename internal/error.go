@@ -1,34 +1,172 @@
 package internal
 
 import (
+	"encoding/json"
 	"strings"
 )
 
+// Issue is a single failed validation.
+type Issue struct {
+	// Code identifies the rule that failed. Unlike Message, it doesn't change with the data, tag, or a custom message.
+	Code string
+	// Path is the dot-separated path of tags leading to the value that failed, or empty for a top-level value.
+	Path string
+	// Message is the (possibly custom) message describing the failure.
+	Message string
+	// Params holds details of the failure for some rules, such as a "suggestion" of what data may have meant.
+	Params map[string]any
+	// Cause is the error behind the failure, if there is one: the error returned by a context-aware rule,
+	// or a sentinel such as z.ErrTypeMismatch or z.ErrRequired.
+	Cause error
+}
+
+// Error returns the issue's message.
+func (i *Issue) Error() string {
+	return i.Message
+}
+
+// Unwrap returns the issue's cause, if any.
+func (i *Issue) Unwrap() error {
+	return i.Cause
+}
+
 type ValidationErrors struct {
-	Errors []string
+	issues    []Issue
+	truncated bool
 }
 
+// NewValidationErrors returns ValidationErrors of issues that only carry a message.
 func NewValidationErrors(errs ...string) *ValidationErrors {
-	return &ValidationErrors{Errors: errs}
+	issues := make([]Issue, len(errs))
+	for i, err := range errs {
+		issues[i] = Issue{Message: err}
+	}
+	return &ValidationErrors{issues: issues}
+}
+
+func NewValidationIssues(issues ...Issue) *ValidationErrors {
+	return &ValidationErrors{issues: issues}
+}
+
+// Append adds issues to the end of e.
+func (e *ValidationErrors) Append(issues ...Issue) {
+	e.issues = append(e.issues, issues...)
+}
+
+// Len returns the number of issues in e.
+func (e *ValidationErrors) Len() int {
+	return len(e.issues)
+}
+
+func (e *ValidationErrors) Issues() []Issue {
+	return e.issues
+}
+
+func (e *ValidationErrors) MarkTruncated() {
+	e.truncated = true
+}
+
+func (e *ValidationErrors) Truncated() bool {
+	return e.truncated
 }
 
+// One returns the message of the first issue, or an empty string if there are none.
 func (e *ValidationErrors) One() string {
-	if len(e.Errors) == 0 {
-		panic("no validation Errors to return")
+	if len(e.issues) == 0 {
+		return ""
 	}
-	return e.Errors[0]
+	return e.issues[0].Message
 }
 
 func (e *ValidationErrors) All() []string {
-	if len(e.Errors) == 0 {
-		panic("no validation Errors to return")
+	msgs := make([]string, len(e.issues))
+	for i, issue := range e.issues {
+		msgs[i] = issue.Message
 	}
-	return e.Errors
+	return msgs
 }
 
 func (e *ValidationErrors) Error() string {
-	if len(e.Errors) == 0 {
-		panic("no validation Errors to return")
+	return strings.Join(e.All(), " | ")
+}
+
+// Unwrap returns the issues, as *Issue, for errors.Is and errors.As.
+func (e *ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e.issues))
+	for i := range e.issues {
+		errs[i] = &e.issues[i]
+	}
+	return errs
+}
+
+// Flatten returns the messages of the issues keyed by their Path.
+func (e *ValidationErrors) Flatten() map[string][]string {
+	flat := make(map[string][]string)
+	for _, issue := range e.issues {
+		flat[issue.Path] = append(flat[issue.Path], issue.Message)
+	}
+	return flat
+}
+
+// Format returns the messages of the issues as a Tree, split along their Path.
+func (e *ValidationErrors) Format() *Tree {
+	root := &Tree{}
+	for _, issue := range e.issues {
+		tree := root
+		for _, segment := range splitPath(issue.Path) {
+			if tree.Fields == nil {
+				tree.Fields = make(map[string]*Tree)
+			}
+			child, ok := tree.Fields[segment]
+			if !ok {
+				child = &Tree{}
+				tree.Fields[segment] = child
+			}
+			tree = child
+		}
+		tree.Errors = append(tree.Errors, issue.Message)
+	}
+	return root
+}
+
+// splitPath splits a path of tags and indexes (e.g. "geometry.coordinates[0][3]") into its segments
+// (e.g. "geometry", "coordinates", "0", "3").
+func splitPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	var segments []string
+	for _, tag := range strings.Split(path, ".") {
+		name, indexes, _ := strings.Cut(tag, "[")
+		if name != "" || indexes == "" {
+			segments = append(segments, name)
+		}
+		if indexes != "" {
+			segments = append(segments, strings.Split(strings.TrimSuffix(indexes, "]"), "][")...)
+		}
+	}
+	return segments
+}
+
+// Tree is a tree of messages, mirroring the shape of the data that failed validation.
+type Tree struct {
+	// Errors are the messages for the value at the tree's own path.
+	Errors []string
+	// Fields are the trees of the values nested within, keyed by tag (or index, within arrays).
+	Fields map[string]*Tree
+}
+
+// MarshalJSON encodes the tree as an object holding its messages under "_errors" (an empty array if there are
+// none), alongside the trees of the values nested within it.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	object := make(map[string]any, len(t.Fields)+1)
+	for key, field := range t.Fields {
+		object[key] = field
+	}
+	errors := t.Errors
+	if errors == nil {
+		errors = []string{}
 	}
-	return strings.Join(e.Errors, " | ")
+	object["_errors"] = errors
+	return json.Marshal(object)
 }
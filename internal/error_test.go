@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// None of the accessors panic, or return nil slices, when there are no issues.
+func TestValidationErrorsEmpty(t *testing.T) {
+	unrelated := errors.New("unrelated")
+	for name, e := range map[string]*ValidationErrors{
+		"NewValidationErrors()": NewValidationErrors(),
+		"NewValidationIssues()": NewValidationIssues(),
+		"zero value":            {},
+	} {
+		if got := e.One(); got != "" {
+			t.Errorf("%s: One() = %q, want \"\"", name, got)
+		}
+		if got := e.All(); got == nil || len(got) != 0 {
+			t.Errorf("%s: All() = %#v, want an empty slice", name, got)
+		}
+		if got := e.Issues(); len(got) != 0 {
+			t.Errorf("%s: Issues() = %#v, want none", name, got)
+		}
+		if got := e.Len(); got != 0 {
+			t.Errorf("%s: Len() = %d, want 0", name, got)
+		}
+		if got := e.Error(); got != "" {
+			t.Errorf("%s: Error() = %q, want \"\"", name, got)
+		}
+		if e.Truncated() {
+			t.Errorf("%s: Truncated() = true, want false", name)
+		}
+		if got := e.Flatten(); got == nil || len(got) != 0 {
+			t.Errorf("%s: Flatten() = %#v, want an empty map", name, got)
+		}
+		if got := e.Format(); got == nil || got.Errors != nil || got.Fields != nil {
+			t.Errorf("%s: Format() = %#v, want an empty tree", name, got)
+		}
+		if errors.Is(e, unrelated) {
+			t.Errorf("%s: errors.Is matched an unrelated error", name)
+		}
+	}
+}
+
+// Issues are matched by errors.As as *Issue, and their causes by errors.Is.
+func TestValidationErrorsUnwrap(t *testing.T) {
+	mismatch, unavailable := errors.New("type mismatch"), errors.New("unavailable")
+	tests := []struct {
+		name   string
+		issues []Issue
+		// is is the error errors.Is should match (if any), and as the message of the issue errors.As should find
+		is error
+		as string
+	}{
+		{"no cause", []Issue{{Message: "a"}}, nil, "a"},
+		{"cause", []Issue{{Message: "a", Cause: mismatch}}, mismatch, "a"},
+		{"cause of a later issue", []Issue{{Message: "a"}, {Message: "b", Cause: unavailable}}, unavailable, "a"},
+		{"wrapped cause", []Issue{{Message: "a", Cause: errors.Join(errors.New("x"), mismatch)}}, mismatch, "a"},
+	}
+	for _, tt := range tests {
+		var err error = NewValidationIssues(tt.issues...)
+		if tt.is != nil && !errors.Is(err, tt.is) {
+			t.Errorf("%s: errors.Is(err, %v) = false, want true", tt.name, tt.is)
+		}
+		if tt.is != mismatch && errors.Is(err, mismatch) {
+			t.Errorf("%s: errors.Is(err, %v) = true, want false", tt.name, mismatch)
+		}
+		var issue *Issue
+		if !errors.As(err, &issue) || issue.Message != tt.as {
+			t.Errorf("%s: errors.As found %+v, want the issue %q", tt.name, issue, tt.as)
+		}
+	}
+
+	// the issue found is the one held, not a copy
+	e := NewValidationIssues(Issue{Message: "a"})
+	var issue *Issue
+	if !errors.As(e, &issue) || issue != &e.Issues()[0] {
+		t.Errorf("errors.As found %p, want %p", issue, &e.Issues()[0])
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name   string
+		issues []Issue
+		want   map[string][]string
+	}{
+		{"top-level", []Issue{{Message: "a"}}, map[string][]string{"": {"a"}}},
+		{"fields", []Issue{{Path: "name", Message: "a"}, {Path: "address.zip", Message: "b"}},
+			map[string][]string{"name": {"a"}, "address.zip": {"b"}}},
+		{"several at one path, in order", []Issue{{Path: "address.zip", Message: "a"}, {Path: "name", Message: "b"}, {Path: "address.zip", Message: "c"}},
+			map[string][]string{"address.zip": {"a", "c"}, "name": {"b"}}},
+		{"indexes", []Issue{{Path: "geometry.coordinates[0][3]", Message: "a"}},
+			map[string][]string{"geometry.coordinates[0][3]": {"a"}}},
+	}
+	for _, tt := range tests {
+		if got := NewValidationIssues(tt.issues...).Flatten(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Flatten() = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		issues []Issue
+		want   *Tree
+		json   string
+	}{
+		{"top-level", []Issue{{Message: "a"}}, &Tree{Errors: []string{"a"}}, `{"_errors":["a"]}`},
+		{"nested field", []Issue{{Path: "address.zip", Message: "a"}},
+			&Tree{Fields: map[string]*Tree{"address": {Fields: map[string]*Tree{"zip": {Errors: []string{"a"}}}}}},
+			`{"_errors":[],"address":{"_errors":[],"zip":{"_errors":["a"]}}}`},
+		{"messages at several levels", []Issue{{Path: "address", Message: "a"}, {Path: "address.zip", Message: "b"}, {Path: "address.zip", Message: "c"}},
+			&Tree{Fields: map[string]*Tree{"address": {Errors: []string{"a"}, Fields: map[string]*Tree{"zip": {Errors: []string{"b", "c"}}}}}},
+			`{"_errors":[],"address":{"_errors":["a"],"zip":{"_errors":["b","c"]}}}`},
+		{"indexes", []Issue{{Path: "geometry.coordinates[0][3]", Message: "a"}},
+			&Tree{Fields: map[string]*Tree{"geometry": {Fields: map[string]*Tree{"coordinates": {Fields: map[string]*Tree{"0": {Fields: map[string]*Tree{"3": {Errors: []string{"a"}}}}}}}}}},
+			`{"_errors":[],"geometry":{"_errors":[],"coordinates":{"_errors":[],"0":{"_errors":[],"3":{"_errors":["a"]}}}}}`},
+		{"index of a top-level array", []Issue{{Path: "[1]", Message: "a"}},
+			&Tree{Fields: map[string]*Tree{"1": {Errors: []string{"a"}}}},
+			`{"_errors":[],"1":{"_errors":["a"]}}`},
+	}
+	for _, tt := range tests {
+		got := NewValidationIssues(tt.issues...).Format()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Format() = %#v, want %#v", tt.name, got, tt.want)
+		}
+		encoded, err := json.Marshal(got)
+		if err != nil {
+			t.Fatalf("%s: json.Marshal = %v", tt.name, err)
+		}
+		if !jsonEqual(t, encoded, tt.json) {
+			t.Errorf("%s: json.Marshal(Format()) = %s, want %s", tt.name, encoded, tt.json)
+		}
+	}
+}
+
+// jsonEqual reports whether the JSON documents a and b are equal, ignoring the order of keys.
+func jsonEqual(t *testing.T, a []byte, b string) bool {
+	t.Helper()
+	var x, y any
+	if err := json.Unmarshal(a, &x); err != nil {
+		t.Fatalf("invalid JSON %s: %v", a, err)
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		t.Fatalf("invalid JSON %s: %v", b, err)
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// GraphemeCount returns the number of user-perceived characters (extended grapheme clusters) in s, following
+// the main rules of Unicode Standard Annex #29: CR LF, Hangul syllables, combining marks and other extending
+// characters, Indic conjuncts (consonants joined by a virama, as in "स्ते"), regional indicator pairs (flags),
+// and emoji joined by zero width joiners. Invalid UTF-8 bytes are counted as a character each.
+func GraphemeCount(s string) int {
+	count := 0
+	prev, prevPrev := rune(-1), rune(-1)
+	regional := 0 // the number of regional indicators in a row, up to and including prev
+	conjunct := 0 // how far prev is into an Indic conjunct: 0 if not, 1 past its consonant, 2 past a linker too
+	for _, r := range s {
+		if prev < 0 || breaks(prevPrev, prev, r, regional, conjunct == 2) {
+			count++
+		}
+		if isRegional(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		switch {
+		case isConjunctConsonant(r):
+			conjunct = 1
+		case isConjunctLinker(r) && conjunct > 0:
+			conjunct = 2
+		case (isExtend(r) || r == zwj) && conjunct > 0:
+			// other extending characters may come before or after the linker, without ending the conjunct
+		default:
+			conjunct = 0
+		}
+		prevPrev, prev = prev, r
+	}
+	return count
+}
+
+// breaks reports whether there's a grapheme cluster boundary between prev and r. linked is set if prev ends a
+// consonant followed by a linker (and any other extending characters), which joins a consonant r to it.
+func breaks(prevPrev, prev, r rune, regional int, linked bool) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case isControl(prev) || isControl(r):
+		return true
+	case hangulContinues(prev, r):
+		return false
+	case isExtend(r) || r == zwj || unicode.Is(unicode.Mc, r):
+		return false
+	case linked && isConjunctConsonant(r):
+		return false
+	case prev == zwj && isPictographic(r) && (isPictographic(prevPrev) || isExtend(prevPrev)):
+		return false
+	case isRegional(prev) && isRegional(r):
+		// regional indicators pair up into flags
+		return regional%2 == 0
+	default:
+		return true
+	}
+}
+
+const zwj = '\u200d'
+
+func isControl(r rune) bool {
+	return r == '\r' || r == '\n' || r == utf8.RuneError || unicode.Is(unicode.Cc, r) ||
+		unicode.Is(unicode.Zl, r) || unicode.Is(unicode.Zp, r)
+}
+
+func isExtend(r rune) bool {
+	return unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) ||
+		0xFE00 <= r && r <= 0xFE0F || // variation selectors
+		0xE0100 <= r && r <= 0xE01EF || // variation selectors supplement
+		0x1F3FB <= r && r <= 0x1F3FF || // emoji skin tone modifiers
+		0xE0020 <= r && r <= 0xE007F // tags
+}
+
+func isRegional(r rune) bool { return 0x1F1E6 <= r && r <= 0x1F1FF }
+
+// isPictographic approximates Extended_Pictographic with the blocks emoji are drawn from.
+func isPictographic(r rune) bool {
+	return r == 0x00A9 || r == 0x00AE || r == 0x203C || r == 0x2049 || r == 0x2122 || r == 0x2139 ||
+		0x2194 <= r && r <= 0x21FF ||
+		0x2300 <= r && r <= 0x23FF ||
+		0x25A0 <= r && r <= 0x27BF ||
+		0x2900 <= r && r <= 0x2BFF ||
+		0x1F000 <= r && r <= 0x1FAFF && !isRegional(r) && !(0x1F3FB <= r && r <= 0x1F3FF)
+}
+
+// conjunctConsonants are the consonants that a linker joins into conjuncts (Indic_Conjunct_Break=Consonant), of
+// the scripts whose virama is a linker: Devanagari, Bengali, Gujarati, Oriya, Telugu and Malayalam.
+var conjunctConsonants = &unicode.RangeTable{R16: []unicode.Range16{
+	{Lo: 0x0915, Hi: 0x0939, Stride: 1}, {Lo: 0x0958, Hi: 0x095F, Stride: 1}, {Lo: 0x0978, Hi: 0x097F, Stride: 1},
+	{Lo: 0x0995, Hi: 0x09A8, Stride: 1}, {Lo: 0x09AA, Hi: 0x09B0, Stride: 1}, {Lo: 0x09B2, Hi: 0x09B2, Stride: 1},
+	{Lo: 0x09B6, Hi: 0x09B9, Stride: 1}, {Lo: 0x09DC, Hi: 0x09DD, Stride: 1}, {Lo: 0x09DF, Hi: 0x09DF, Stride: 1},
+	{Lo: 0x09F0, Hi: 0x09F1, Stride: 1},
+	{Lo: 0x0A95, Hi: 0x0AA8, Stride: 1}, {Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1}, {Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+	{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1}, {Lo: 0x0AF9, Hi: 0x0AF9, Stride: 1},
+	{Lo: 0x0B15, Hi: 0x0B28, Stride: 1}, {Lo: 0x0B2A, Hi: 0x0B30, Stride: 1}, {Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+	{Lo: 0x0B35, Hi: 0x0B39, Stride: 1}, {Lo: 0x0B5C, Hi: 0x0B5D, Stride: 1}, {Lo: 0x0B5F, Hi: 0x0B5F, Stride: 1},
+	{Lo: 0x0B71, Hi: 0x0B71, Stride: 1},
+	{Lo: 0x0C15, Hi: 0x0C28, Stride: 1}, {Lo: 0x0C2A, Hi: 0x0C39, Stride: 1}, {Lo: 0x0C58, Hi: 0x0C5A, Stride: 1},
+	{Lo: 0x0D15, Hi: 0x0D3A, Stride: 1},
+}}
+
+func isConjunctConsonant(r rune) bool { return unicode.Is(conjunctConsonants, r) }
+
+// isConjunctLinker reports whether r is the virama of one of the scripts of conjunctConsonants.
+func isConjunctLinker(r rune) bool {
+	switch r {
+	case 0x094D, 0x09CD, 0x0ACD, 0x0B4D, 0x0C4D, 0x0D4D:
+		return true
+	default:
+		return false
+	}
+}
+
+// hangulContinues reports whether r continues a Hangul syllable ending in prev.
+func hangulContinues(prev, r rune) bool {
+	switch {
+	case hangulL(prev):
+		return hangulL(r) || hangulV(r) || hangulLV(r) || hangulLVT(r)
+	case hangulLV(prev) || hangulV(prev):
+		return hangulV(r) || hangulT(r)
+	case hangulLVT(prev) || hangulT(prev):
+		return hangulT(r)
+	default:
+		return false
+	}
+}
+
+func hangulL(r rune) bool { return 0x1100 <= r && r <= 0x115F || 0xA960 <= r && r <= 0xA97C }
+func hangulV(r rune) bool { return 0x1160 <= r && r <= 0x11A7 || 0xD7B0 <= r && r <= 0xD7C6 }
+func hangulT(r rune) bool { return 0x11A8 <= r && r <= 0x11FF || 0xD7CB <= r && r <= 0xD7FB }
+
+func hangulLV(r rune) bool { return 0xAC00 <= r && r <= 0xD7A3 && (r-0xAC00)%28 == 0 }
+
+func hangulLVT(r rune) bool { return 0xAC00 <= r && r <= 0xD7A3 && (r-0xAC00)%28 != 0 }
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// The vectors are written in the notation of Unicode's GraphemeBreakTest.txt: code points in hex, with ÷ marking
+// a grapheme cluster boundary and × the lack of one.
+func TestGraphemeCount(t *testing.T) {
+	tests := []string{
+		// GB3, GB4, GB5: CR LF, and controls
+		"÷ 000D × 000A ÷",
+		"÷ 000A ÷ 000D ÷",
+		"÷ 0061 ÷ 000A ÷ 0062 ÷",
+		// GB6, GB7, GB8: Hangul syllables
+		"÷ 1100 × 1161 × 11A8 ÷",
+		"÷ AC00 × 11A8 ÷ 1100 ÷",
+		"÷ D4DB ÷ AC00 ÷",
+		// GB9, GB9a: extending characters, ZWJ and spacing marks
+		"÷ 0065 × 0301 ÷",
+		"÷ 0065 × 0301 × 0302 ÷ 0061 ÷",
+		"÷ 0915 × 093F ÷",
+		"÷ 0061 × 200D ÷ 0062 ÷",
+		// GB9c: Indic conjuncts
+		"÷ 0915 × 094D × 0924 ÷",
+		"÷ 0915 × 094D × 094D × 0924 ÷",
+		"÷ 0915 × 094D × 200D × 0924 ÷",
+		"÷ 0915 × 093C × 200D × 094D × 0924 ÷",
+		"÷ 0915 × 093C × 094D × 200D × 0924 ÷",
+		"÷ 0915 × 094D × 0924 × 094D × 092F ÷",
+		"÷ 0915 × 094D ÷ 0061 ÷",
+		"÷ 0061 × 094D ÷ 0924 ÷",
+		"÷ 003F × 094D ÷ 0924 ÷",
+		"÷ 0915 ÷ 0924 ÷",
+		"÷ 0928 ÷ 092E ÷ 0938 × 094D × 0924 × 0947 ÷", // नमस्ते
+		"÷ 0995 × 09CD × 09B7 ÷",
+		"÷ 0D15 × 0D4D × 0D15 ÷",
+		// GB11: emoji joined by ZWJ
+		"÷ 1F468 × 200D × 1F469 × 200D × 1F467 ÷",
+		"÷ 1F44D × 1F3FD ÷ 1F44D ÷",
+		"÷ 0061 × 200D ÷ 1F469 ÷",
+		// GB12, GB13: regional indicators pair up into flags
+		"÷ 1F1FA × 1F1F8 ÷",
+		"÷ 1F1FA × 1F1F8 ÷ 1F1EB × 1F1F7 ÷",
+		"÷ 1F1FA × 1F1F8 ÷ 1F1EB ÷",
+		// GB999
+		"÷ 0061 ÷ 0062 ÷",
+	}
+	for _, test := range tests {
+		var s strings.Builder
+		want := -1
+		for _, field := range strings.Fields(test) {
+			switch field {
+			case "÷":
+				want++
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatalf("%s: invalid code point %q", test, field)
+				}
+				s.WriteRune(rune(r))
+			}
+		}
+		if got := GraphemeCount(s.String()); got != want {
+			t.Errorf("GraphemeCount(%s) = %d, want %d", test, got, want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package z
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+var _ ContextValidatable = (*ValidatableLazy)(nil)
+
+// ValidatableLazy is a schema that is resolved when validated, allowing schemas to refer to themselves.
+type ValidatableLazy struct {
+	schema   func() Validatable
+	maxDepth int
+}
+
+// Validate resolves the schema and validates data against it.
+//
+//	Returns Errors if:
+//	=> the schema has been resolved more than MaxDepth times on the way to (non-nil) data
+//	=> data fails the resolved schema's validation
+func (l *ValidatableLazy) Validate(data any, tags ...string) Errors {
+	return validate(l, data, tags...)
+}
+
+// ValidateContext validates data against the resolved schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (l *ValidatableLazy) ValidateContext(ctx context.Context, data any, tags ...string) (Errors, error) {
+	return validateContext(ctx, l, data, tags...)
+}
+
+func (l *ValidatableLazy) walk(r *run, data any, tags ...string) Errors {
+	if l.maxDepth > 0 && r.depth >= l.maxDepth && !isNil(data) {
+		if len(tags) > 0 {
+			return r.errors(CodeMaxDepth, tags, fmt.Sprintf("<%s> failed validation for <lazy> (max depth of %d exceeded)", tags[0], l.maxDepth))
+		}
+		return r.errors(CodeMaxDepth, nil, fmt.Sprintf("failed validation for <lazy> (max depth of %d exceeded)", l.maxDepth))
+	}
+	next := *r
+	next.depth++
+	return next.walk(l.schema(), data, tags...)
+}
+
+func (l *ValidatableLazy) writes() bool {
+	w, ok := l.schema().(writer)
+	return ok && w.writes()
+}
+
+// MaxDepth limits how many times the schema may be resolved within itself. Data nested deeper fails validation.
+// A depth of zero (the default) means no limit; cyclic data is still caught by Struct.
+func (l *ValidatableLazy) MaxDepth(depth int) *ValidatableLazy {
+	l.maxDepth = depth
+	return l
+}
+
+// Lazy returns a ValidatableLazy for validating recursive data, such as trees or linked lists.
+// The schema function is called each time the schema is validated, so it may refer to the
+// schema being declared:
+//
+//	var comment z.Struct
+//	comment = z.Struct{
+//		"body":  z.String().NotEmpty(),
+//		"reply": z.Lazy(func() z.Validatable { return comment.Optional() }),
+//	}
+func Lazy(schema func() Validatable) *ValidatableLazy { return &ValidatableLazy{schema: schema} }
+
+// isNil reports whether data is nil or a nil pointer.
+func isNil(data any) bool {
+	if data == nil {
+		return true
+	}
+	value := reflect.ValueOf(data)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
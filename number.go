@@ -0,0 +1,346 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// number is what the arbitrary-precision schemas (BigInt, BigRat and Decimal) share. Values are converted to
+// a big.Rat, so they are compared exactly, whatever form they came in.
+type number struct {
+	// kind names the type being validated in messages (e.g. "big.Int").
+	kind   string
+	tag    *string
+	value  *big.Rat
+	digits numberDigits
+	// rules build the rules of the schema for the copy of it made to validate a value (see walk).
+	rules    []func(n *number) rule
+	optional bool
+	failFast bool
+	// parse converts data to a number, reporting false if data isn't of the schema's type.
+	// A nil pointer is reported as a nil *big.Rat.
+	parse func(data any) (*big.Rat, numberDigits, bool)
+	// errs are the errors hit while building the schema.
+	errs []error
+	run  *run
+	// catch, if set by Catch, swallows errs for data that failed validation, writing the fallback into data if
+	// it points to a value the fallback can be written as.
+	catch func(data any, errs Errors) Errors
+}
+
+// numberDigits describes the decimal digits of a number, as in SQL's NUMERIC(precision, scale).
+type numberDigits struct {
+	// precision is the number of digits, not counting leading zeros before the decimal point.
+	precision int
+	// scale is the number of digits after the decimal point, or -1 if there are infinitely many (e.g. 1/3).
+	scale int
+}
+
+func (n *number) err() error {
+	return errors.Join(n.errs...)
+}
+
+// walk validates data against a copy of the schema, which holds the value being validated, so the schema can
+// validate any number of values at a time.
+func (n *number) walk(r *run, data any, tag ...string) Errors {
+	c := *n
+	return c.walkCopy(r, data, tag...)
+}
+
+// walkCopy is walk for the copy of the schema made to validate data.
+func (n *number) walkCopy(r *run, data any, tag ...string) Errors {
+	if len(tag) > 0 {
+		n.tag = &tag[0]
+	}
+	if err := n.err(); err != nil {
+		r.fail(err)
+		return nil
+	}
+	parsed := numberData(data)
+	if n.optional && parsed == nil {
+		return nil
+	}
+	value, digits, ok := n.parse(parsed)
+	if ok && value == nil && n.optional {
+		return nil
+	}
+	if !ok || value == nil {
+		return r.report(n.recover(data, typeMismatch(n.kind, n.tag, data)))
+	}
+	n.value, n.digits = value, digits
+	n.run = r
+	vErrors := n.run.check(bind(n, n.rules), n.failFast, n.tag)
+	if len(vErrors) > 0 {
+		return r.report(n.recover(data, internal.NewValidationIssues(vErrors...)))
+	}
+	return nil
+}
+
+// recover applies the schema's fallback (if any) to errs, found validating data.
+func (n *number) recover(data any, errs Errors) Errors {
+	if n.catch == nil {
+		return errs
+	}
+	return n.catch(data, errs)
+}
+
+// setCatch marks the schema with a fallback, written as text into string and json.Number pointers (unless text is
+// empty), and by write into any other data.
+func (n *number) setCatch(text string, warnings []*Warnings, write func(data any)) {
+	f := newFallback(text, warnings)
+	n.catch = func(data any, errs Errors) Errors {
+		switch target := data.(type) {
+		case *string:
+			if target != nil && text != "" {
+				*target = text
+			}
+		case *json.Number:
+			if target != nil && text != "" {
+				*target = json.Number(text)
+			}
+		default:
+			if write != nil {
+				write(data)
+			}
+		}
+		return f.apply(nil, errs)
+	}
+}
+
+// numberData dereferences a json.Number pointer (e.g. a struct field handed over by address for Catch), so it
+// parses like any other json.Number.
+func numberData(data any) any {
+	if p, ok := data.(*json.Number); ok {
+		if p == nil {
+			return nil
+		}
+		return *p
+	}
+	return data
+}
+
+// bound checks a bound given to a rule, recording a schema error (and reporting false) if it's unusable.
+func (n *number) bound(name string, bound *big.Rat) bool {
+	if bound == nil {
+		n.errs = append(n.errs, fmt.Errorf("%w: %s: nil bound", ErrInvalidSchema, name))
+		return false
+	}
+	return true
+}
+
+// compare appends a rule validating that data compares to bound as accepted.
+func (n *number) compare(code, name string, bound *big.Rat, accepted func(cmp int) bool, msg []string) {
+	if !n.bound(name, bound) {
+		return
+	}
+	n.requirement(code, name, func(n *number) bool { return accepted(n.value.Cmp(bound)) }, msg)
+}
+
+func (n *number) requirement(code, name string, meets func(n *number) bool, msg []string) {
+	n.rules = append(n.rules, func(n *number) rule {
+		return rule{code: code, check: func() string {
+			switch {
+			case meets(n):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <%s> validation for <%s>", *n.tag, n.kind, name)
+			default:
+				return fmt.Sprintf("failed <%s> validation for <%s>", n.kind, name)
+			}
+		}}
+	})
+}
+
+func (n *number) lt(max *big.Rat, text string, msg []string) {
+	n.compare(CodeLt, "Lt("+text+")", max, func(cmp int) bool { return cmp < 0 }, msg)
+}
+
+func (n *number) gt(min *big.Rat, text string, msg []string) {
+	n.compare(CodeGt, "Gt("+text+")", min, func(cmp int) bool { return cmp > 0 }, msg)
+}
+
+func (n *number) lte(max *big.Rat, text string, msg []string) {
+	n.compare(CodeLte, "Lte("+text+")", max, func(cmp int) bool { return cmp <= 0 }, msg)
+}
+
+func (n *number) gte(min *big.Rat, text string, msg []string) {
+	n.compare(CodeGte, "Gte("+text+")", min, func(cmp int) bool { return cmp >= 0 }, msg)
+}
+
+func (n *number) eq(to *big.Rat, text string, msg []string) {
+	n.compare(CodeEq, "Eq("+text+")", to, func(cmp int) bool { return cmp == 0 }, msg)
+}
+
+func (n *number) notEq(to *big.Rat, text string, msg []string) {
+	n.compare(CodeNotEq, "NotEq("+text+")", to, func(cmp int) bool { return cmp != 0 }, msg)
+}
+
+func (n *number) between(min, max *big.Rat, minText, maxText string, msg []string) {
+	name := "Range(" + minText + ", " + maxText + ")"
+	if !n.bound(name, min) || !n.bound(name, max) {
+		return
+	}
+	n.requirement(CodeRange, name, func(n *number) bool { return n.value.Cmp(min) >= 0 && n.value.Cmp(max) <= 0 }, msg)
+}
+
+func (n *number) sign(code, name string, accepted func(sign int) bool, msg []string) {
+	n.requirement(code, name, func(n *number) bool { return accepted(n.value.Sign()) }, msg)
+}
+
+func (n *number) scale(max int, msg []string) {
+	n.rules = append(n.rules, func(n *number) rule {
+		return rule{code: CodeScale, check: func() string {
+			switch {
+			case n.digits.scale >= 0 && n.digits.scale <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <%s> validation for <Scale(%d)>", *n.tag, n.kind, max)
+			default:
+				return fmt.Sprintf("failed <%s> validation for <Scale(%d)>", n.kind, max)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"scale": max}
+		}}
+	})
+}
+
+func (n *number) precision(max int, msg []string) {
+	n.rules = append(n.rules, func(n *number) rule {
+		return rule{code: CodePrecision, check: func() string {
+			switch {
+			case n.digits.scale >= 0 && n.digits.precision <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <%s> validation for <Precision(%d)>", *n.tag, n.kind, max)
+			default:
+				return fmt.Sprintf("failed <%s> validation for <Precision(%d)>", n.kind, max)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"digits": max}
+		}}
+	})
+}
+
+func (n *number) custom(fn func(n *number) bool, msg []string) {
+	n.requirement(CodeCustom, "Custom", fn, msg)
+}
+
+func (n *number) customCtx(fn func(ctx context.Context, n *number) error, msg []string) {
+	n.rules = append(n.rules, func(n *number) rule {
+		var err error
+		return rule{code: CodeCustomCtx, check: func() string {
+			err = fn(n.run.ctx, n)
+			switch {
+			case err == nil:
+				return ""
+			case !errors.Is(err, ErrInvalid):
+				n.run.fail(err)
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case n.tag != nil:
+				return fmt.Sprintf("<%s> failed <%s> validation for <CustomCtx>", *n.tag, n.kind)
+			default:
+				return fmt.Sprintf("failed <%s> validation for <CustomCtx>", n.kind)
+			}
+		}, cause: func() error { return err }}
+	})
+}
+
+// maxDecimalExponent is the largest exponent (in magnitude) a decimal may be written with.
+const maxDecimalExponent = 10000
+
+// parseDecimal parses s as a decimal number (e.g. "-12.50", "1.5e3"), returning its digits as written,
+// so "1.50" has a scale of 2. Reports false if s isn't a decimal number.
+func parseDecimal(s string) (*big.Rat, numberDigits, bool) {
+	rest := strings.TrimLeft(s, "+-")
+	if len(s)-len(rest) > 1 {
+		return nil, numberDigits{}, false
+	}
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(rest), "e")
+	whole, fraction, hasPoint := strings.Cut(mantissa, ".")
+	switch {
+	case whole == "" && fraction == "",
+		whole != "" && !isDigits(whole),
+		fraction != "" && !isDigits(fraction),
+		hasPoint && fraction == "",
+		hasExponent && !isDigits(strings.TrimLeft(exponent, "+-")),
+		hasExponent && len(exponent)-len(strings.TrimLeft(exponent, "+-")) > 1:
+		return nil, numberDigits{}, false
+	}
+	exp := 0
+	if hasExponent {
+		var err error
+		// huge exponents would have the value take up as much memory, so they're taken not to be numbers
+		if exp, err = strconv.Atoi(exponent); err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return nil, numberDigits{}, false
+		}
+	}
+	value, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, numberDigits{}, false
+	}
+
+	// with the digits D and the exponent e, the value is D × 10^(e - len(fraction))
+	significant := strings.TrimLeft(whole+fraction, "0")
+	digits := numberDigits{}
+	if shift := len(fraction) - exp; shift > 0 {
+		digits.scale = shift
+		if len(significant) > shift {
+			digits.precision = len(significant) - shift
+		}
+	} else if significant != "" {
+		digits.precision = len(significant) - shift
+	}
+	digits.precision += digits.scale
+	return value, digits, true
+}
+
+// ratDigits returns the digits of r, as it would be written as a decimal.
+func ratDigits(r *big.Rat) numberDigits {
+	// a fraction has a terminating decimal expansion if, reduced, its denominator has no prime factors but 2 and 5,
+	// in which case it needs as many decimal places as the larger power of the two
+	denominator := new(big.Int).Set(r.Denom())
+	twos, fives := 0, 0
+	two, five, zero := big.NewInt(2), big.NewInt(5), new(big.Int)
+	remainder := new(big.Int)
+	for {
+		quotient, _ := new(big.Int).QuoRem(denominator, two, remainder)
+		if remainder.Cmp(zero) != 0 {
+			break
+		}
+		denominator, twos = quotient, twos+1
+	}
+	for {
+		quotient, _ := new(big.Int).QuoRem(denominator, five, remainder)
+		if remainder.Cmp(zero) != 0 {
+			break
+		}
+		denominator, fives = quotient, fives+1
+	}
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return numberDigits{scale: -1}
+	}
+	scale := twos
+	if fives > scale {
+		scale = fives
+	}
+	whole := new(big.Int).Quo(r.Num(), r.Denom())
+	precision := 0
+	if whole.Sign() != 0 {
+		precision = len(whole.Abs(whole).String())
+	}
+	return numberDigits{precision: precision + scale, scale: scale}
+}
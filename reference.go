@@ -0,0 +1,202 @@
+package z
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Country is an ISO 3166-1 country.
+type Country struct {
+	// Alpha2 is the country's alpha-2 code (e.g. "DE").
+	Alpha2 string
+	// Alpha3 is the country's alpha-3 code (e.g. "DEU").
+	Alpha3 string
+	// Numeric is the country's numeric code (e.g. "276").
+	Numeric string
+}
+
+// Language is an ISO 639-1 language.
+type Language struct {
+	// Alpha2 is the language's ISO 639-1 code (e.g. "de").
+	Alpha2 string
+	// Alpha3 is the language's ISO 639-2/T code (e.g. "deu").
+	Alpha3 string
+}
+
+var (
+	//go:embed data/iso3166-1.csv
+	iso3166 string
+	//go:embed data/iso3166-2.csv
+	iso3166Subdivisions string
+	//go:embed data/iso639-1.csv
+	iso639 string
+)
+
+// references are the reference datasets, loaded from their tables the first time they're needed.
+var references struct {
+	once         sync.Once
+	countries    []Country
+	countryCodes map[string]Country
+	subdivisions map[string][]string
+	languages    []Language
+	languageCode map[string]Language
+}
+
+func loadReferences() {
+	references.once.Do(func() {
+		references.countryCodes = make(map[string]Country)
+		for _, row := range readTable("ISO 3166-1", iso3166, 3) {
+			country := Country{Alpha2: row[0], Alpha3: row[1], Numeric: row[2]}
+			references.countries = append(references.countries, country)
+			references.countryCodes[country.Alpha2] = country
+			references.countryCodes[country.Alpha3] = country
+			references.countryCodes[country.Numeric] = country
+		}
+		references.subdivisions = make(map[string][]string)
+		for _, row := range readTable("ISO 3166-2", iso3166Subdivisions, 1) {
+			country, _, _ := strings.Cut(row[0], "-")
+			references.subdivisions[country] = append(references.subdivisions[country], row[0])
+		}
+		references.languageCode = make(map[string]Language)
+		for _, row := range readTable("ISO 639-1", iso639, 2) {
+			language := Language{Alpha2: row[0], Alpha3: row[1]}
+			references.languages = append(references.languages, language)
+			references.languageCode[language.Alpha2] = language
+			references.languageCode[language.Alpha3] = language
+		}
+	})
+}
+
+// readTable reads the rows of an embedded table of comma-separated values, skipping blank lines and comments.
+// It panics if a row doesn't have as many columns as expected, as the table is part of the package.
+func readTable(name, table string, columns int) [][]string {
+	var rows [][]string
+	for _, line := range strings.Split(table, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		row := strings.Split(line, ",")
+		if len(row) != columns {
+			panic("z: malformed " + name + " table: " + line)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Countries returns the ISO 3166-1 countries, sorted by alpha-2 code.
+func Countries() []Country {
+	loadReferences()
+	return append([]Country(nil), references.countries...)
+}
+
+// LookupCountry returns the ISO 3166-1 country with the (uppercase) alpha-2 or alpha-3 code, or the numeric code.
+func LookupCountry(code string) (Country, bool) {
+	loadReferences()
+	country, ok := references.countryCodes[code]
+	return country, ok
+}
+
+// Subdivisions returns the ISO 3166-2 subdivision codes (e.g. "US-CA") of the country with the alpha-2 code, sorted,
+// or nil if the country has none (e.g. "AQ") or isn't a country. Subdivisions nested within others (e.g. the
+// departments of France's regions) are listed alongside them.
+func Subdivisions(country string) []string {
+	loadReferences()
+	return append([]string(nil), references.subdivisions[country]...)
+}
+
+// Languages returns the ISO 639-1 languages, sorted by alpha-2 code.
+func Languages() []Language {
+	loadReferences()
+	return append([]Language(nil), references.languages...)
+}
+
+// LookupLanguage returns the ISO 639-1 language with the (lowercase) ISO 639-1 or ISO 639-2/T code.
+func LookupLanguage(code string) (Language, bool) {
+	loadReferences()
+	language, ok := references.languageCode[code]
+	return language, ok
+}
+
+// CountryAlpha2 appends a rule validating that data is an (uppercase) ISO 3166-1 alpha-2 country code (e.g. "DE").
+func (v *ValidatableString) CountryAlpha2(msg ...string) *ValidatableString {
+	v.requirement(CodeCountryAlpha2, "CountryAlpha2", func(v *ValidatableString) bool {
+		country, ok := LookupCountry(v.value)
+		return ok && country.Alpha2 == v.value
+	}, msg)
+	return v
+}
+
+// CountryAlpha3 appends a rule validating that data is an (uppercase) ISO 3166-1 alpha-3 country code (e.g. "DEU").
+func (v *ValidatableString) CountryAlpha3(msg ...string) *ValidatableString {
+	v.requirement(CodeCountryAlpha3, "CountryAlpha3", func(v *ValidatableString) bool {
+		country, ok := LookupCountry(v.value)
+		return ok && country.Alpha3 == v.value
+	}, msg)
+	return v
+}
+
+// CountryNumeric appends a rule validating that data is a three-digit ISO 3166-1 numeric country code (e.g. "276").
+func (v *ValidatableString) CountryNumeric(msg ...string) *ValidatableString {
+	v.requirement(CodeCountryNumeric, "CountryNumeric", func(v *ValidatableString) bool {
+		country, ok := LookupCountry(v.value)
+		return ok && country.Numeric == v.value
+	}, msg)
+	return v
+}
+
+// Subdivision appends a rule validating that data is an ISO 3166-2 subdivision code (e.g. "US-CA"): an alpha-2
+// country code, a hyphen, and up to three uppercase letters or digits, that is one of the country's subdivisions.
+// See Subdivisions.
+func (v *ValidatableString) Subdivision(msg ...string) *ValidatableString {
+	v.requirement(CodeSubdivision, "Subdivision", func(v *ValidatableString) bool {
+		country, _, ok := strings.Cut(v.value, "-")
+		if !ok {
+			return false
+		}
+		loadReferences()
+		for _, code := range references.subdivisions[country] {
+			if code == v.value {
+				return true
+			}
+		}
+		return false
+	}, msg)
+	return v
+}
+
+// LanguageTag appends a rule validating that data is a well-formed BCP 47 language tag made up of known subtags
+// (e.g. "en", "pt-BR", "zh-Hant-TW"), as understood by golang.org/x/text/language.
+func (v *ValidatableString) LanguageTag(msg ...string) *ValidatableString {
+	v.requirement(CodeLanguageTag, "LanguageTag", func(v *ValidatableString) bool {
+		// the parser accepts underscores as separators, which BCP 47 doesn't
+		if strings.Contains(v.value, "_") {
+			return false
+		}
+		_, err := language.Parse(v.value)
+		return err == nil
+	}, msg)
+	return v
+}
+
+// LanguageAlpha2 appends a rule validating that data is a (lowercase) ISO 639-1 language code (e.g. "de").
+func (v *ValidatableString) LanguageAlpha2(msg ...string) *ValidatableString {
+	v.requirement(CodeLanguageAlpha2, "LanguageAlpha2", func(v *ValidatableString) bool {
+		language, ok := LookupLanguage(v.value)
+		return ok && language.Alpha2 == v.value
+	}, msg)
+	return v
+}
+
+// LanguageAlpha3 appends a rule validating that data is the (lowercase) ISO 639-2/T code of an ISO 639-1 language
+// (e.g. "deu"). Languages without an ISO 639-1 code aren't covered.
+func (v *ValidatableString) LanguageAlpha3(msg ...string) *ValidatableString {
+	v.requirement(CodeLanguageAlpha3, "LanguageAlpha3", func(v *ValidatableString) bool {
+		language, ok := LookupLanguage(v.value)
+		return ok && language.Alpha3 == v.value
+	}, msg)
+	return v
+}
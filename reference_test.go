@@ -0,0 +1,23 @@
+package z
+
+import "testing"
+
+func TestSubdivision(t *testing.T) {
+	good := []string{"US-CA", "DE-BY", "FR-75", "FR-IDF", "GB-ENG", "JP-13", "IN-MH", "CN-BJ", "NZ-AUK"}
+	// countries without subdivisions (AQ, VA) have no valid codes
+	bad := []string{"US-XX", "FR-ABC", "JP-99", "AQ-AB", "VA-01", "XX-AB", "us-ca", "US", "US-", "US-CALI", ""}
+	schema := String().Subdivision()
+	for _, s := range good {
+		if errs := schema.Validate(s); errs != nil {
+			t.Errorf("Validate(%q) = %v, want no errors", s, errs)
+		}
+	}
+	for _, s := range bad {
+		if errs := schema.Validate(s); errs == nil {
+			t.Errorf("Validate(%q) = nil, want errors", s)
+		}
+	}
+	if got := len(Subdivisions("AQ")); got != 0 {
+		t.Errorf("Subdivisions(AQ) has %d codes, want none", got)
+	}
+}
@@ -0,0 +1,335 @@
+package z
+
+import (
+	"context"
+	"errors"
+	"github.com/MarcusSanchez/go-z/internal"
+	"reflect"
+	"sync"
+)
+
+// run is the state of a single validation, carried down through nested schemas.
+type run struct {
+	ctx context.Context
+	// depth is the number of Lazy schemas expanded on the way to the current value.
+	depth int
+	// seen is the chain of struct pointers passed through on the way to the current value.
+	seen *visit
+	// fields are the values of the z-tagged fields of the innermost struct being validated, by tag, as they were
+	// before any of their schemas ran. Nil pointers are held as nil.
+	fields map[string]any
+	// outcome and tally are shared by every copy of the run.
+	outcome *outcome
+	tally   *tally
+	options options
+}
+
+// options configure a run. They are carried by the context passed to ValidateContext.
+type options struct {
+	// concurrency is the number of fields a Struct may validate at a time.
+	concurrency int
+	// maxErrors is the number of errors after which the run stops. Zero means no limit.
+	maxErrors int
+}
+
+type optionsKey struct{}
+
+func optionsFrom(ctx context.Context) options {
+	opts, _ := ctx.Value(optionsKey{}).(options)
+	return opts
+}
+
+// WithConcurrency returns a copy of ctx that, when passed to ValidateContext, has each Struct validate up to
+// limit of its fields concurrently. This pays off when fields have slow, context-aware rules (e.g. CustomCtx
+// rules that query a database). Errors are returned in the same order as when validating sequentially.
+func WithConcurrency(ctx context.Context, limit int) context.Context {
+	opts := optionsFrom(ctx)
+	opts.concurrency = limit
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+// WithFailFast returns a copy of ctx that, when passed to ValidateContext, stops validation at the first error.
+// If that leaves rules or fields unvalidated, the returned Errors are marked as truncated. To stop each primitive
+// at its first failing rule, use FailFast.
+func WithFailFast(ctx context.Context) context.Context {
+	return WithMaxErrors(ctx, 1)
+}
+
+// WithMaxErrors returns a copy of ctx that, when passed to ValidateContext, stops validation once max errors
+// have been found. If that drops errors or leaves rules or fields unvalidated, the returned Errors are marked as
+// truncated. Combined with WithConcurrency, which errors are kept may vary between runs, though they are still
+// returned in order.
+func WithMaxErrors(ctx context.Context, max int) context.Context {
+	opts := optionsFrom(ctx)
+	opts.maxErrors = max
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+type visit struct {
+	ptr    uintptr
+	typ    reflect.Type
+	parent *visit
+}
+
+// outcome records the first error (that isn't a validation failure) hit during a run.
+type outcome struct {
+	mu  sync.Mutex
+	err error
+}
+
+// tally counts the errors found during a run, so it can stop once it has found enough of them.
+type tally struct {
+	mu     sync.Mutex
+	errors int
+	// truncated is set once an error is dropped, or a rule or field is skipped, for the run reaching its limit.
+	truncated bool
+}
+
+func newRun(ctx context.Context) *run {
+	return &run{ctx: ctx, outcome: &outcome{}, tally: &tally{}, options: optionsFrom(ctx)}
+}
+
+// walker is implemented by schemas that carry a run down to the schemas nested within them.
+type walker interface {
+	walk(r *run, data any, tags ...string) Errors
+}
+
+// walkFunc adapts a function to a walker.
+type walkFunc func(r *run, data any, tags ...string) Errors
+
+func (f walkFunc) walk(r *run, data any, tags ...string) Errors { return f(r, data, tags...) }
+
+// walk validates data against schema as part of the run. Schemas that don't implement walker are
+// validated on their own, passing along the run's context if they implement ContextValidatable.
+func (r *run) walk(schema Validatable, data any, tags ...string) Errors {
+	switch schema := schema.(type) {
+	case walker:
+		return schema.walk(r, data, tags...)
+	case ContextValidatable:
+		errs, err := schema.ValidateContext(r.ctx, data, tags...)
+		if err != nil {
+			r.fail(err)
+		}
+		return r.report(errs)
+	default:
+		return r.report(schema.Validate(data, tags...))
+	}
+}
+
+// check runs rules in order, returning issues at path for those that fail. Rules are no longer run
+// once the run is done or reaches its error limit, or once a rule fails if failFast is set.
+func (r *run) check(rules []rule, failFast bool, path *string) []Issue {
+	vErrors := make([]Issue, 0, len(rules))
+	for _, rule := range rules {
+		if r.done(len(vErrors)) {
+			break
+		}
+		if rule.issues != nil {
+			issues := rule.issues()
+			vErrors = append(vErrors, issues...)
+			if len(issues) > 0 && failFast {
+				break
+			}
+			continue
+		}
+		if err := rule.check(); err != "" {
+			issue := Issue{Code: rule.code, Message: err}
+			if path != nil {
+				issue.Path = *path
+			}
+			if rule.params != nil {
+				issue.Params = rule.params()
+			}
+			if rule.cause != nil {
+				issue.Cause = rule.cause()
+			}
+			vErrors = append(vErrors, issue)
+			if failFast {
+				break
+			}
+		}
+	}
+	return vErrors
+}
+
+// bind builds the rules of schema, the copy of a schema made to validate a value. Rules are built for the copy
+// they run against, rather than closing over the schema itself, so that values validated at the same time (under
+// WithConcurrency, or by a schema nested within itself through Lazy) don't share state.
+func bind[S any](schema *S, builders []func(schema *S) rule) []rule {
+	rules := make([]rule, len(builders))
+	for i, build := range builders {
+		rules[i] = build(schema)
+	}
+	return rules
+}
+
+// errors returns Errors with code for msgs at the path of the first of tags (if any), as found by the schema being
+// walked.
+func (r *run) errors(code string, tags []string, msgs ...string) Errors {
+	issues := make([]Issue, len(msgs))
+	for i, msg := range msgs {
+		issues[i] = Issue{Code: code, Message: msg}
+		if len(tags) > 0 {
+			issues[i].Path = tags[0]
+		}
+	}
+	return r.report(internal.NewValidationIssues(issues...))
+}
+
+// report counts errs towards the run's error limit, if it has one, and returns those within the limit.
+// Every error should be reported once, by the schema that found it.
+func (r *run) report(errs Errors) Errors {
+	if errs == nil || r.tally == nil || r.options.maxErrors <= 0 {
+		return errs
+	}
+	r.tally.mu.Lock()
+	defer r.tally.mu.Unlock()
+
+	issues := errs.Issues()
+	if remaining := r.options.maxErrors - r.tally.errors; len(issues) > remaining {
+		if remaining < 0 {
+			remaining = 0
+		}
+		issues = issues[:remaining]
+		r.tally.truncated = true
+	}
+	r.tally.errors += len(issues)
+	if len(issues) == 0 {
+		return nil
+	}
+	return internal.NewValidationIssues(issues...)
+}
+
+// untallied returns a copy of the run whose errors don't count towards its error limit,
+// for schemas that may swallow the errors found within them (e.g. CatchStruct).
+func (r *run) untallied() *run {
+	next := *r
+	next.tally = nil
+	return &next
+}
+
+// finish prepares errs to be returned at the end of the run.
+func (r *run) finish(errs Errors) Errors {
+	if errs == nil || r.options.maxErrors <= 0 {
+		return errs
+	}
+	r.tally.mu.Lock()
+	defer r.tally.mu.Unlock()
+
+	issues := errs.Issues()
+	if len(issues) > r.options.maxErrors {
+		issues = issues[:r.options.maxErrors]
+		r.tally.truncated = true
+	}
+	vErrors := internal.NewValidationIssues(issues...)
+	if r.tally.truncated {
+		vErrors.MarkTruncated()
+	}
+	return vErrors
+}
+
+// enter returns a copy of the run that has passed through the (non-nil) pointer ptr.
+// It reports false if ptr was already passed through, meaning the data is cyclic.
+func (r *run) enter(ptr reflect.Value) (*run, bool) {
+	for v := r.seen; v != nil; v = v.parent {
+		if v.ptr == ptr.Pointer() && v.typ == ptr.Type() {
+			return r, false
+		}
+	}
+	next := *r
+	next.seen = &visit{ptr: ptr.Pointer(), typ: ptr.Type(), parent: r.seen}
+	return &next, true
+}
+
+// within returns a copy of the run validating the fields of a struct, whose values are by tag.
+//
+// The values are copied (dereferencing pointers) before any of the fields' schemas run, so that rules reading
+// other fields (e.g. a password compared against a username) see them as they were given: a schema writing back
+// into its field (e.g. with WriteBack or Catch) can't race with them under WithConcurrency, and concurrent runs
+// agree with sequential ones.
+func (r *run) within(values map[string]any) *run {
+	fields := make(map[string]any, len(values))
+	for tag, value := range values {
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				fields[tag] = nil
+				continue
+			}
+			value = rv.Elem().Interface()
+		}
+		fields[tag] = value
+	}
+	next := *r
+	next.fields = fields
+	return &next
+}
+
+// field returns the value of the field tagged tag in the innermost struct being validated, as it was before any
+// schema ran, dereferenced if it's a pointer. Reports false if there's no such field, or it's a nil pointer.
+func (r *run) field(tag string) (any, bool) {
+	value, ok := r.fields[tag]
+	return value, ok && value != nil
+}
+
+// fail records err as the run's error, unless one was already recorded.
+func (r *run) fail(err error) {
+	r.outcome.mu.Lock()
+	defer r.outcome.mu.Unlock()
+	if r.outcome.err == nil {
+		r.outcome.err = err
+	}
+}
+
+// err returns the run's error, if any.
+func (r *run) err() error {
+	r.outcome.mu.Lock()
+	defer r.outcome.mu.Unlock()
+	return r.outcome.err
+}
+
+// done reports whether the run should stop before its next step (a rule or a field), either because its context
+// is done, because it hit an error, or because it found as many errors as it's limited to, counting pending errors
+// found but not yet reported. Callers skip the step if it reports true, so the run is marked truncated.
+func (r *run) done(pending int) bool {
+	if err := r.ctx.Err(); err != nil {
+		r.fail(err)
+		return true
+	}
+	if r.err() != nil {
+		return true
+	}
+	if r.tally == nil || r.options.maxErrors <= 0 {
+		return false
+	}
+	r.tally.mu.Lock()
+	defer r.tally.mu.Unlock()
+	if r.tally.errors+pending >= r.options.maxErrors {
+		r.tally.truncated = true
+	}
+	return r.tally.truncated
+}
+
+// validate validates data against w in a run of its own. As Validate has no other way of reporting them, errors
+// that aren't validation failures are appended to the returned Errors, with the code CodeInvalidSchema or CodeAborted.
+func validate(w walker, data any, tags ...string) Errors {
+	r := newRun(context.Background())
+	errs := r.finish(w.walk(r, data, tags...))
+	if err := r.err(); err != nil {
+		issue := Issue{Code: CodeAborted, Message: err.Error(), Cause: err}
+		if errors.Is(err, ErrInvalidSchema) {
+			issue.Code = CodeInvalidSchema
+		}
+		if errs == nil {
+			return internal.NewValidationIssues(issue)
+		}
+		return internal.NewValidationIssues(append(errs.Issues(), issue)...)
+	}
+	return errs
+}
+
+// validateContext validates data against w in a run bound to ctx.
+func validateContext(ctx context.Context, w walker, data any, tags ...string) (Errors, error) {
+	r := newRun(ctx)
+	errs := r.finish(w.walk(r, data, tags...))
+	return errs, r.err()
+}
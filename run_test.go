@@ -0,0 +1,69 @@
+package z
+
+import (
+	"context"
+	"testing"
+)
+
+// Errors are only marked as truncated when an error was dropped, or a rule or field skipped, for the run reaching
+// its error limit: finding exactly as many errors as the limit allows, with nothing left to validate, isn't truncation.
+func TestTruncated(t *testing.T) {
+	type fields struct {
+		A int `z:"a"`
+		B int `z:"b"`
+		C int `z:"c"`
+	}
+	positive := Struct{"a": Int().Positive(), "b": Int().Positive(), "c": Int().Positive()}
+
+	tests := []struct {
+		name      string
+		schema    Validatable
+		data      any
+		maxErrors int
+		// errors is the number of errors returned, and truncated whether they're marked as truncated
+		errors    int
+		truncated bool
+	}{
+		{"FailFast, over limit", Int().Gt(5).Lt(0).FailFast(), 1, 0, 1, false},
+		{"FailFast, no errors", Int().Gt(5).FailFast(), 10, 0, 0, false},
+		{"WithFailFast, under limit", Int().Gt(5), 10, 1, 0, false},
+		{"WithFailFast, exact limit", Int().Gt(5), 1, 1, 1, false},
+		{"WithFailFast, over limit", Int().Gt(5).Lt(0), 1, 1, 1, true},
+		{"WithFailFast, skipped rule", Int().Gt(5).Positive(), 1, 1, 1, true},
+		{"WithMaxErrors, rules under limit", String().NotEmpty().Min(5), "abc", 2, 1, false},
+		{"WithMaxErrors, rules at exact limit", String().NotEmpty().Min(5).Max(1), "abc", 2, 2, false},
+		{"WithMaxErrors, rules over limit", String().Min(5).Max(1).Email(), "abc", 2, 2, true},
+		{"WithMaxErrors, fields under limit", positive, fields{A: 1, B: 1, C: 0}, 2, 1, false},
+		{"WithMaxErrors, fields at exact limit", positive, fields{A: 1, B: 0, C: 0}, 2, 2, false},
+		{"WithMaxErrors, fields over limit", positive, fields{A: 0, B: 0, C: 0}, 2, 2, true},
+		{"WithMaxErrors, nested issues over limit", String().JSONOf(func() any { return &fields{} }, positive), `{}`, 2, 2, true},
+	}
+	for _, tt := range tests {
+		for _, concurrency := range []int{1, 3} {
+			ctx := WithConcurrency(context.Background(), concurrency)
+			if tt.maxErrors > 0 {
+				ctx = WithMaxErrors(ctx, tt.maxErrors)
+			}
+			errs, err := tt.schema.(ContextValidatable).ValidateContext(ctx, tt.data)
+			if err != nil {
+				t.Fatalf("%s, concurrency %d: ValidateContext = %v", tt.name, concurrency, err)
+			}
+			var got int
+			if errs != nil {
+				got = len(errs.Issues())
+			}
+			if got != tt.errors {
+				t.Errorf("%s, concurrency %d: got %d errors, want %d", tt.name, concurrency, got, tt.errors)
+			}
+			if errs != nil && errs.Truncated() != tt.truncated {
+				t.Errorf("%s, concurrency %d: Truncated() = %v, want %v", tt.name, concurrency, errs.Truncated(), tt.truncated)
+			}
+		}
+	}
+
+	// WithFailFast is WithMaxErrors(ctx, 1)
+	errs, _ := Int().Gt(5).ValidateContext(WithFailFast(context.Background()), 1)
+	if errs == nil || errs.Truncated() {
+		t.Errorf("WithFailFast: got %v, want one error, not truncated", errs)
+	}
+}
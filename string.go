@@ -1,219 +1,436 @@
 package z
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MarcusSanchez/go-z/internal"
-	"net/mail"
 	"regexp"
+	"unicode/utf8"
 )
 
-var _ Validatable = (*ValidatableString)(nil)
+var _ ContextValidatable = (*ValidatableString)(nil)
 
 // ValidatableString is a string that can be validated.
 type ValidatableString struct {
-	tag      *string
-	value    string
-	rules    []rule
+	tag   *string
+	value string
+	// rules build the rules of the schema for the copy of it made to validate a value (see walk).
+	rules    []func(v *ValidatableString) rule
 	optional bool
+	failFast bool
+	unit     Unit
+	// transforms are applied to data, in order, before any rule is run.
+	transforms []func(string) string
+	writeBack  bool
+	suggest    bool
+	// layout is the layout times are parsed with, as set by RFC3339, Date or Layout.
+	layout string
+	parsed parsedTime
+	// errs are the errors hit while building the schema.
+	errs     []error
+	run      *run
+	fallback *fallback[string]
 }
 
 // Validate validates a string against its schema.
 //
 //	Returns Errors if:
+//	=> the schema is invalid (see Err)
 //	=> data is not a string
 //	=> data fails any of the schema's rules
 func (v *ValidatableString) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a string against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableString) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+// walk validates data against a copy of the schema, which holds the value being validated, so the schema can
+// validate any number of values at a time.
+func (v *ValidatableString) walk(r *run, data any, tag ...string) Errors {
+	c := *v
+	return c.walkCopy(r, data, tag...)
+}
+
+// walkCopy is walk for the copy of the schema made to validate data.
+func (v *ValidatableString) walkCopy(r *run, data any, tag ...string) Errors {
 	if len(tag) > 0 {
 		v.tag = &tag[0]
 	}
-	if v.optional {
-		if data == nil {
+	if err := v.Err(); err != nil {
+		r.fail(err)
+		return nil
+	}
+	if v.optional && data == nil {
+		return nil
+	}
+	var target *string
+	if value, ok := data.(*string); ok && (v.optional || v.writes()) {
+		if value == nil && v.optional {
 			return nil
 		}
-		if value, ok := data.(*string); ok {
-			if value == nil {
-				return nil
-			}
+		if value != nil {
+			target = value
 			data = *value
 		}
 	}
 	var ok bool
 	if v.value, ok = data.(string); !ok {
-		if v.tag == nil {
-			return internal.NewValidationErrors("failed validation for <string>")
-		}
-		return internal.NewValidationErrors(fmt.Sprintf("<%s> failed validation for <string>", *v.tag))
+		return r.report(v.fail(target, typeMismatch("string", v.tag, data)))
 	}
-	vErrors := make([]string, 0, len(v.rules))
-	for _, rule := range v.rules {
-		if err := rule(); err != "" {
-			vErrors = append(vErrors, err)
-		}
+	for _, transform := range v.transforms {
+		v.value = transform(v.value)
 	}
+	v.run = r
+	vErrors := v.run.check(bind(v, v.rules), v.failFast, v.tag)
 	if len(vErrors) > 0 {
-		return internal.NewValidationErrors(vErrors...)
+		return r.report(v.fail(target, internal.NewValidationIssues(vErrors...)))
+	}
+	if v.writeBack && target != nil {
+		*target = v.value
 	}
 	return nil
 }
 
+// fail applies the schema's fallback (if any) to errs, taking the fallback as the value.
+func (v *ValidatableString) fail(target *string, errs Errors) Errors {
+	if v.fallback != nil {
+		v.value = v.fallback.value
+	}
+	return v.fallback.apply(target, errs)
+}
+
+// Err returns the errors hit while building the schema, such as a Regex that doesn't compile, joined together.
+// A schema with errors doesn't validate data; Validate returns them as Errors, and ValidateContext as its error.
+func (v *ValidatableString) Err() error {
+	return errors.Join(v.errs...)
+}
+
 // Optional marks the string as optional. Calling Validate with nil or a nil string pointer will skip validation.
 func (v *ValidatableString) Optional() *ValidatableString {
 	v.optional = true
 	return v
 }
 
-// Min appends a rule validating that data is greater than or equal to the provided min. (len(data) >= min)
+// FailFast stops validating the string at its first failing rule, rather than running every rule.
+func (v *ValidatableString) FailFast() *ValidatableString {
+	v.failFast = true
+	return v
+}
+
+// Catch marks the string with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// string pointer (or a struct field validated through a struct pointer), the fallback is written into it.
+// The swallowed issues are recorded in warnings, if provided.
+func (v *ValidatableString) Catch(value string, warnings ...*Warnings) *ValidatableString {
+	v.fallback = newFallback(value, warnings)
+	return v
+}
+
+// WriteBack marks the string to write the value its rules validated, after transforms such as Normalize, back into
+// data when it passes validation. Data must be a string pointer (or a struct field validated through a struct pointer).
+func (v *ValidatableString) WriteBack() *ValidatableString {
+	v.writeBack = true
+	return v
+}
+
+func (v *ValidatableString) writes() bool { return v.fallback != nil || v.writeBack }
+
+// Min appends a rule validating that data is at least the provided min long. (len(data) >= min)
+// Length is counted in bytes, unless changed with CountBy.
 func (v *ValidatableString) Min(min int, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case len(v.value) >= min:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Min(%d)>", *v.tag, min)
-		default:
-			return fmt.Sprintf("failed <string> validation for <Min(%d)>", min)
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeMin, check: func() string {
+			switch {
+			case v.length() >= min:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Min(%d)>", *v.tag, min)
+			default:
+				return fmt.Sprintf("failed <string> validation for <Min(%d)>", min)
+			}
+		}}
 	})
 	return v
 }
 
-// Max appends a rule validating that data is less than or equal to the provided max. (len(data) <= max)
+// Max appends a rule validating that data is at most the provided max long. (len(data) <= max)
+// Length is counted in bytes, unless changed with CountBy.
 func (v *ValidatableString) Max(max int, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case len(v.value) <= max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Max(%d)>", *v.tag, max)
-		default:
-			return fmt.Sprintf("failed <string> validation for <Max(%d)>", max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeMax, check: func() string {
+			switch {
+			case v.length() <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Max(%d)>", *v.tag, max)
+			default:
+				return fmt.Sprintf("failed <string> validation for <Max(%d)>", max)
+			}
+		}}
 	})
 	return v
 }
 
 // Email appends a rule validating that data is a valid email address under RFC-5322.
 func (v *ValidatableString) Email(msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		a, err := mail.ParseAddress(v.value)
-		switch {
-		case err == nil && a.Address == v.value:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Email>", *v.tag)
-		default:
-			return "failed <string> validation for <Email>"
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeEmail, check: func() string {
+			switch {
+			case isEmail(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Email>", *v.tag)
+			default:
+				return "failed <string> validation for <Email>"
+			}
+		}}
 	})
 	return v
 }
 
 // Eq appends a rule validating that data is equal to the provided value. (data == value)
 func (v *ValidatableString) Eq(value string, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value == value:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Eq(%s)>", *v.tag, value)
-		default:
-			return fmt.Sprintf("failed <string> validation for <Eq(%s)>", value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeEq, check: func() string {
+			switch {
+			case v.value == value:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Eq(%s)>", *v.tag, value)
+			default:
+				return fmt.Sprintf("failed <string> validation for <Eq(%s)>", value)
+			}
+		}}
 	})
 	return v
 }
 
 // NotEq appends a rule validating that data is not equal to the provided value. (data != value)
 func (v *ValidatableString) NotEq(value string, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != value:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <NotEq(%s)>", *v.tag, value)
-		default:
-			return fmt.Sprintf("failed <string> validation for <NotEq(%s)>", value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeNotEq, check: func() string {
+			switch {
+			case v.value != value:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <NotEq(%s)>", *v.tag, value)
+			default:
+				return fmt.Sprintf("failed <string> validation for <NotEq(%s)>", value)
+			}
+		}}
 	})
 	return v
 }
 
 // NotEmpty appends a rule validating that data is not an empty string.
 func (v *ValidatableString) NotEmpty(msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != "":
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <NotEmpty>", *v.tag)
-		default:
-			return "failed <string> validation for <NotEmpty>"
-		}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeNotEmpty, check: func() string {
+			switch {
+			case v.value != "":
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <NotEmpty>", *v.tag)
+			default:
+				return "failed <string> validation for <NotEmpty>"
+			}
+		}}
 	})
 	return v
 }
 
 // In appends a rule validating that data is in the provided slice of values.
+// With Suggest, the value closest to data is suggested when it fails.
 func (v *ValidatableString) In(values []string, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		for _, value := range values {
-			if v.value == value {
-				return ""
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeIn, check: func() string {
+			for _, value := range values {
+				if v.value == value {
+					return ""
+				}
 			}
-		}
-		if len(msg) > 0 {
-			return msg[0]
-		}
-		if v.tag != nil {
-			return fmt.Sprintf("<%s> failed <%T> validation for <In(%s)>", *v.tag, v.value, values)
-		}
-		return fmt.Sprintf("failed <%T> validation for <In(%s)>", v.value, values)
+			if len(msg) > 0 {
+				return msg[0]
+			}
+			var hint string
+			if suggestion, ok := v.suggestion(values); ok {
+				hint = fmt.Sprintf(" (did you mean %q?)", suggestion)
+			}
+			if v.tag != nil {
+				return fmt.Sprintf("<%s> failed <%T> validation for <In(%v)>%s", *v.tag, v.value, values, hint)
+			}
+			return fmt.Sprintf("failed <%T> validation for <In(%v)>%s", v.value, values, hint)
+		}, params: func() map[string]any {
+			if suggestion, ok := v.suggestion(values); ok {
+				return map[string]any{"suggestion": suggestion}
+			}
+			return nil
+		}}
 	})
 	return v
 }
 
-// Regex appends a rule validating that data matches the provided regex.
+// Suggest makes In suggest the allowed value closest to data (by edit distance) when it fails, in the issue's
+// Params under "suggestion" and, unless the message is custom, in the message itself ("did you mean ...?").
+// Values are only suggested if they're within a third of data's length (and at least 2) edits of it.
+func (v *ValidatableString) Suggest() *ValidatableString {
+	v.suggest = true
+	return v
+}
+
+// suggestion returns the value closest to data, if suggestions are enabled and one is close enough.
+func (v *ValidatableString) suggestion(values []string) (string, bool) {
+	if !v.suggest {
+		return "", false
+	}
+	return closest(v.value, values)
+}
+
+// closest returns the candidate closest to s, if it's within a third of its length (and at least 2) edits of s.
+func closest(s string, candidates []string) (string, bool) {
+	maxDistance := utf8.RuneCountInString(s) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	candidate, ok := internal.Closest(s, candidates, maxDistance)
+	return candidate, ok && candidate != s
+}
+
+// Regex appends a rule validating that data matches the provided regex. The regex is compiled once, here;
+// if it doesn't compile, the schema is invalid (see Err, and Struct.Err for checking a whole schema at startup).
 func (v *ValidatableString) Regex(regex string, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case regexp.MustCompile(regex).MatchString(v.value):
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Regex(%s)>", *v.tag, regex)
-		default:
-			return "failed <string> validation for <Regex(" + regex + ")>"
-		}
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("%w: Regex(%s): %v", ErrInvalidSchema, regex, err))
+		return v
+	}
+	return v.Regexp(re, msg...)
+}
+
+// Regexp appends a rule validating that data matches the provided compiled regex. A nil regex makes the schema
+// invalid (see Err).
+func (v *ValidatableString) Regexp(re *regexp.Regexp, msg ...string) *ValidatableString {
+	if re == nil {
+		v.errs = append(v.errs, fmt.Errorf("%w: Regexp: nil regexp", ErrInvalidSchema))
+		return v
+	}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeRegex, check: func() string {
+			switch {
+			case re.MatchString(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Regex(%s)>", *v.tag, re)
+			default:
+				return fmt.Sprintf("failed <string> validation for <Regex(%s)>", re)
+			}
+		}}
 	})
 	return v
 }
 
+// requirement appends a rule validating that data meets a requirement of a policy, such as EmailPolicy, where
+// name describes the requirement in messages (e.g. "Email(RequireTLD)").
+func (v *ValidatableString) requirement(code, name string, meets func(v *ValidatableString) bool, msg []string) {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: code, check: func() string {
+			switch {
+			case meets(v):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <%s>", *v.tag, name)
+			default:
+				return fmt.Sprintf("failed <string> validation for <%s>", name)
+			}
+		}}
+	})
+}
+
+// diagnosis appends a rule validating data with diagnose, which returns a detail of what's wrong with data (e.g.
+// where it's malformed) and the params describing it, or "" if nothing is. The detail is appended to the default message.
+func (v *ValidatableString) diagnosis(code, name string, diagnose func(v *ValidatableString) (string, map[string]any), msg []string) {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		// the params of the diagnosis, for the issue if it fails
+		var params map[string]any
+		return rule{code: code, check: func() string {
+			var detail string
+			detail, params = diagnose(v)
+			switch {
+			case detail == "":
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <%s> (%s)", *v.tag, name, detail)
+			default:
+				return fmt.Sprintf("failed <string> validation for <%s> (%s)", name, detail)
+			}
+		}, params: func() map[string]any { return params }}
+	})
+}
+
 // Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data.
-func (v *ValidatableString) Custom(rule func(s string) bool, msg ...string) *ValidatableString {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case rule(v.value):
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <string> validation for <Custom>", *v.tag)
-		default:
-			return "failed <string> validation for <Custom>"
-		}
+func (v *ValidatableString) Custom(fn func(s string) bool, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeCustom, check: func() string {
+			switch {
+			case fn(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Custom>", *v.tag)
+			default:
+				return "failed <string> validation for <Custom>"
+			}
+		}}
+	})
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext.
+func (v *ValidatableString) CustomCtx(fn func(ctx context.Context, s string) error, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		var err error
+		return rule{code: CodeCustomCtx, check: func() string {
+			err = fn(v.run.ctx, v.value)
+			switch {
+			case err == nil:
+				return ""
+			case !errors.Is(err, ErrInvalid):
+				v.run.fail(err)
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <CustomCtx>", *v.tag)
+			default:
+				return "failed <string> validation for <CustomCtx>"
+			}
+		}, cause: func() error { return err }}
 	})
 	return v
 }
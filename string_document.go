@@ -0,0 +1,268 @@
+package z
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CardBrand is the brand of a payment card, as detected from its number.
+type CardBrand string
+
+const (
+	CardUnknown    CardBrand = ""
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+	CardDiscover   CardBrand = "discover"
+	CardDiners     CardBrand = "diners"
+	CardJCB        CardBrand = "jcb"
+	CardUnionPay   CardBrand = "unionpay"
+	CardMaestro    CardBrand = "maestro"
+)
+
+// cardRange is a range of card number prefixes (IINs), all of the same number of digits, issued to a brand.
+type cardRange struct {
+	brand    CardBrand
+	low      int
+	high     int
+	lengths  []int
+	digitsIn int
+}
+
+// cardRanges are checked in order, so narrower ranges come before the wider ranges they overlap with.
+var cardRanges = []cardRange{
+	{CardAmex, 34, 34, []int{15}, 2},
+	{CardAmex, 37, 37, []int{15}, 2},
+	{CardDiners, 300, 305, []int{14, 15, 16, 17, 18, 19}, 3},
+	{CardDiners, 36, 36, []int{14, 15, 16, 17, 18, 19}, 2},
+	{CardDiners, 38, 39, []int{14, 15, 16, 17, 18, 19}, 2},
+	{CardJCB, 3528, 3589, []int{16, 17, 18, 19}, 4},
+	{CardVisa, 4, 4, []int{13, 16, 19}, 1},
+	{CardMastercard, 51, 55, []int{16}, 2},
+	{CardMastercard, 2221, 2720, []int{16}, 4},
+	{CardDiscover, 6011, 6011, []int{16, 17, 18, 19}, 4},
+	{CardDiscover, 644, 649, []int{16, 17, 18, 19}, 3},
+	{CardDiscover, 65, 65, []int{16, 17, 18, 19}, 2},
+	{CardUnionPay, 62, 62, []int{16, 17, 18, 19}, 2},
+	{CardMaestro, 50, 50, []int{12, 13, 14, 15, 16, 17, 18, 19}, 2},
+	{CardMaestro, 56, 69, []int{12, 13, 14, 15, 16, 17, 18, 19}, 2},
+}
+
+// DetectCardBrand returns the brand of the card number, judging by its prefix and length,
+// or CardUnknown. It doesn't check the number's Luhn check digit.
+func DetectCardBrand(number string) CardBrand {
+	if !isDigits(number) {
+		return CardUnknown
+	}
+	for _, r := range cardRanges {
+		if len(number) < r.digitsIn {
+			continue
+		}
+		prefix, _ := strconv.Atoi(number[:r.digitsIn])
+		if prefix < r.low || prefix > r.high {
+			continue
+		}
+		for _, length := range r.lengths {
+			if len(number) == length {
+				return r.brand
+			}
+		}
+	}
+	return CardUnknown
+}
+
+// StripSeparators transforms data before any rule is run, removing the spaces and dashes numbers are often written
+// with (e.g. "4111 1111 1111 1111", "978-0-306-40615-7"). Use WriteBack to write the stripped string back into data.
+func (v *ValidatableString) StripSeparators() *ValidatableString {
+	v.transforms = append(v.transforms, stripSeparators)
+	return v
+}
+
+func stripSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.Is(unicode.Pd, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Luhn appends a rule validating that data is a string of digits whose last digit is a valid Luhn (mod 10) check digit.
+func (v *ValidatableString) Luhn(msg ...string) *ValidatableString {
+	v.requirement(CodeLuhn, "Luhn", func(v *ValidatableString) bool { return isLuhn(v.value) }, msg)
+	return v
+}
+
+// CreditCard appends a rule validating that data is a payment card number: 12 to 19 digits, passing the Luhn check.
+// If brands are provided, the number's brand (see DetectCardBrand) must be one of them.
+func (v *ValidatableString) CreditCard(brands []CardBrand, msg ...string) *ValidatableString {
+	name := "CreditCard"
+	if len(brands) > 0 {
+		name = fmt.Sprintf("CreditCard(%v)", brands)
+	}
+	v.requirement(CodeCreditCard, name, func(v *ValidatableString) bool {
+		if len(v.value) < 12 || len(v.value) > 19 || !isLuhn(v.value) {
+			return false
+		}
+		if len(brands) == 0 {
+			return true
+		}
+		brand := DetectCardBrand(v.value)
+		for _, b := range brands {
+			if b == brand {
+				return true
+			}
+		}
+		return false
+	}, msg)
+	return v
+}
+
+// IBAN appends a rule validating that data is an International Bank Account Number in its electronic form (uppercase,
+// without spaces): a known country code, two check digits passing the mod 97 check, and an account number of the
+// length used by that country.
+func (v *ValidatableString) IBAN(msg ...string) *ValidatableString {
+	v.requirement(CodeIBAN, "IBAN", func(v *ValidatableString) bool { return isIBAN(v.value) }, msg)
+	return v
+}
+
+// ISBN appends a rule validating that data is an ISBN-10 or ISBN-13, without separators.
+func (v *ValidatableString) ISBN(msg ...string) *ValidatableString {
+	v.requirement(CodeISBN, "ISBN", func(v *ValidatableString) bool { return isISBN10(v.value) || isISBN13(v.value) }, msg)
+	return v
+}
+
+// ISBN10 appends a rule validating that data is an ISBN-10 (whose check digit may be an "X"), without separators.
+func (v *ValidatableString) ISBN10(msg ...string) *ValidatableString {
+	v.requirement(CodeISBN, "ISBN10", func(v *ValidatableString) bool { return isISBN10(v.value) }, msg)
+	return v
+}
+
+// ISBN13 appends a rule validating that data is an ISBN-13, without separators.
+func (v *ValidatableString) ISBN13(msg ...string) *ValidatableString {
+	v.requirement(CodeISBN, "ISBN13", func(v *ValidatableString) bool { return isISBN13(v.value) }, msg)
+	return v
+}
+
+// EAN appends a rule validating that data is an EAN-8 or EAN-13 barcode number with a valid check digit.
+func (v *ValidatableString) EAN(msg ...string) *ValidatableString {
+	v.requirement(CodeEAN, "EAN", func(v *ValidatableString) bool { return (len(v.value) == 8 || len(v.value) == 13) && isGTIN(v.value) }, msg)
+	return v
+}
+
+// UPC appends a rule validating that data is a UPC-A barcode number (12 digits) with a valid check digit.
+func (v *ValidatableString) UPC(msg ...string) *ValidatableString {
+	v.requirement(CodeUPC, "UPC", func(v *ValidatableString) bool { return len(v.value) == 12 && isGTIN(v.value) }, msg)
+	return v
+}
+
+// E164 appends a rule validating that data is a phone number in E.164 format: a "+" followed by a country code
+// and subscriber number of at most 15 digits in all, not starting with 0 (e.g. "+14155552671").
+func (v *ValidatableString) E164(msg ...string) *ValidatableString {
+	v.requirement(CodeE164, "E164", func(v *ValidatableString) bool {
+		digits := strings.TrimPrefix(v.value, "+")
+		return len(digits) < len(v.value) && len(digits) >= 2 && len(digits) <= 15 && digits[0] != '0' && isDigits(digits)
+	}, msg)
+	return v
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isLuhn(s string) bool {
+	if len(s) < 2 || !isDigits(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		digit := int(s[len(s)-1-i] - '0')
+		if i%2 == 1 {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// isGTIN reports whether s is a GS1 number (EAN, UPC, GTIN) whose last digit is a valid check digit.
+func isGTIN(s string) bool {
+	if len(s) < 2 || !isDigits(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(s)-1; i++ {
+		// weights alternate 3, 1, ... from the digit left of the check digit
+		weight := 1
+		if (len(s)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(s[i]-'0') * weight
+	}
+	return (10-sum%10)%10 == int(s[len(s)-1]-'0')
+}
+
+func isISBN10(s string) bool {
+	if len(s) != 10 || !isDigits(s[:9]) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * (10 - i)
+	}
+	switch check := s[9]; {
+	case check == 'X' || check == 'x':
+		sum += 10
+	case '0' <= check && check <= '9':
+		sum += int(check - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+func isISBN13(s string) bool {
+	return len(s) == 13 && (strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979")) && isGTIN(s)
+}
+
+func isIBAN(s string) bool {
+	if len(s) < 4 || ibanLengths[s[:2]] != len(s) || !isDigits(s[2:4]) {
+		return false
+	}
+	// move the country code and check digits to the end, replace letters with numbers (A = 10, ..., Z = 35),
+	// and check the result mod 97 is 1, computing it piecewise as the number is too large for an int
+	remainder := 0
+	for _, c := range s[4:] + s[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// ibanLengths are the lengths of IBANs by country code, per the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
@@ -0,0 +1,132 @@
+package z
+
+import "testing"
+
+func TestDetectCardBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		want   CardBrand
+	}{
+		{"4111111111111111", CardVisa},
+		{"4222222222222", CardVisa},
+		{"5555555555554444", CardMastercard},
+		{"2221000000000009", CardMastercard},
+		{"378282246310005", CardAmex},
+		{"371449635398431", CardAmex},
+		{"36227206271667", CardDiners},
+		{"3530111333300000", CardJCB},
+		{"6011111111111117", CardDiscover},
+		{"6221260000000000", CardUnionPay},
+		{"6759649826438453", CardMaestro},
+		{"501800000009", CardMaestro},
+		// prefixes shared between brands are told apart by length: 6011 and 65 are Discover
+		// with 16 to 19 digits, and fall within Maestro's 56-69 with fewer
+		{"601100000004", CardMaestro},
+		{"6011000000000004", CardDiscover},
+		{"650000000002", CardMaestro},
+		// Amex is only ever 15 digits
+		{"3782822463100050", CardUnknown},
+		{"1234567890123", CardUnknown},
+		{"4111-1111-1111-1111", CardUnknown},
+		{"", CardUnknown},
+	}
+	for _, tt := range tests {
+		if got := DetectCardBrand(tt.number); got != tt.want {
+			t.Errorf("DetectCardBrand(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestDocumentChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(string) bool
+		good  []string
+		bad   []string
+	}{
+		{
+			name:  "isLuhn",
+			check: isLuhn,
+			good:  []string{"4111111111111111", "378282246310005", "6759649826438453", "79927398713"},
+			bad:   []string{"4111111111111112", "378282246310006", "79927398710", "7", "4111 1111 1111 1111", ""},
+		},
+		{
+			name:  "isIBAN",
+			check: isIBAN,
+			good:  []string{"GB82WEST12345698765432", "DE89370400440532013000", "GB29NWBK60161331926819"},
+			bad: []string{
+				"GB82WEST12345698765433",  // check digits don't match
+				"DE89370400440532013001",  // check digits don't match
+				"DE8937040044053201300",   // too short for DE
+				"GB82WEST123456987654321", // too long for GB
+				"GB82 WEST 1234 5698 7654 32",
+				"gb82west12345698765432",
+				"XX82WEST12345698765432", // unknown country
+				"GB",
+			},
+		},
+		{
+			name:  "isISBN10",
+			check: isISBN10,
+			good:  []string{"0306406152", "080442957X", "080442957x", "0471958697"},
+			bad:   []string{"0306406153", "0804429570", "X804429575", "030640615", "0-306-40615-2"},
+		},
+		{
+			name:  "isISBN13",
+			check: isISBN13,
+			good:  []string{"9780306406157", "9791090636071"},
+			bad:   []string{"9780306406158", "4006381333931", "978030640615"},
+		},
+		{
+			name:  "isGTIN",
+			check: isGTIN,
+			// EAN-8, EAN-13 and UPC-A
+			good: []string{"96385074", "73513537", "4006381333931", "036000291452"},
+			bad:  []string{"96385075", "4006381333932", "036000291453", "03600029145A", "0"},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.good {
+			if !tt.check(s) {
+				t.Errorf("%s(%q) = false, want true", tt.name, s)
+			}
+		}
+		for _, s := range tt.bad {
+			if tt.check(s) {
+				t.Errorf("%s(%q) = true, want false", tt.name, s)
+			}
+		}
+	}
+}
+
+func TestDocumentRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *ValidatableString
+		good   []string
+		bad    []string
+	}{
+		{"CreditCard", String().CreditCard(nil), []string{"4111111111111111", "501800000009"}, []string{"4111111111111112", "42424242424", "79927398713"}},
+		{"CreditCard(brands)", String().CreditCard([]CardBrand{CardVisa, CardAmex}), []string{"4111111111111111", "378282246310005"}, []string{"5555555555554444", "6759649826438453"}},
+		{"StripSeparators", String().StripSeparators().CreditCard(nil), []string{"4111 1111 1111 1111", "4111-1111-1111-1111"}, []string{"4111 1111 1111 1112"}},
+		{"IBAN", String().IBAN(), []string{"GB82WEST12345698765432", "DE89370400440532013000"}, []string{"GB82WEST12345698765433", "DE89370400440532013001"}},
+		{"ISBN", String().ISBN(), []string{"080442957X", "9780306406157"}, []string{"0804429570", "9780306406158"}},
+		{"ISBN10", String().ISBN10(), []string{"080442957X"}, []string{"9780306406157"}},
+		{"ISBN13", String().ISBN13(), []string{"9780306406157"}, []string{"080442957X"}},
+		{"EAN", String().EAN(), []string{"96385074", "4006381333931"}, []string{"036000291452", "96385075", "4006381333932"}},
+		{"UPC", String().UPC(), []string{"036000291452"}, []string{"036000291453", "4006381333931", "96385074"}},
+		{"E164", String().E164(), []string{"+14155552671", "+442071838750", "+123456789012345"}, []string{"14155552671", "+0123456789", "+1234567890123456", "+1", "+1 415 555 2671", "+"}},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.good {
+			if errs := tt.schema.Validate(s); errs != nil {
+				t.Errorf("%s: Validate(%q) = %v, want no errors", tt.name, s, errs)
+			}
+		}
+		for _, s := range tt.bad {
+			if errs := tt.schema.Validate(s); errs == nil {
+				t.Errorf("%s: Validate(%q) = nil, want errors", tt.name, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package z
+
+import (
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+	"net/mail"
+	"strings"
+)
+
+// PlusAddressing is how an email policy treats plus addressing ("user+tag@example.com").
+type PlusAddressing int
+
+const (
+	// PlusAllow accepts plus addressing as is.
+	PlusAllow PlusAddressing = iota
+	// PlusReject fails validation for addresses with a "+tag".
+	PlusReject
+	// PlusStrip removes the "+tag" from addresses before any rule is run, so "user+tag@example.com"
+	// is validated (and, with WriteBack, written back) as "user@example.com".
+	PlusStrip
+)
+
+// EmailPolicy configures the rules appended by EmailWith, on top of the RFC 5322 syntax checked by Email.
+// The zero value only checks the syntax.
+type EmailPolicy struct {
+	// RequireTLD requires the domain to be dotted and end in an alphabetic (or IDNA "xn--") top-level domain,
+	// rejecting addresses such as "user@localhost".
+	RequireTLD bool
+	// AllowDomains, if not empty, are the only domains accepted. DenyDomains are domains that aren't.
+	// Domains are compared case-insensitively, and a domain of the form "*.example.com" matches any subdomain.
+	AllowDomains []string
+	DenyDomains  []string
+	// DisposableDomains are the domains of disposable (throwaway) email providers, which are rejected
+	// along with their subdomains.
+	DisposableDomains []string
+	// LimitLengths caps the local part at 64 bytes and the address at 254 bytes, per RFC 5321.
+	LimitLengths bool
+	// PlusAddressing is how addresses with a "+tag" are treated. Defaults to PlusAllow.
+	PlusAddressing PlusAddressing
+	// SuggestDomains, if not empty, are well-known domains (e.g. CommonEmailDomains) that addresses are checked
+	// against for typos. When an address fails another requirement of the policy and its domain is close to, but
+	// isn't, one of them (e.g. "gmial.com" failing AllowDomains), the corrected address is suggested in the issue's
+	// message and its Params under "suggestion". A typo alone doesn't fail validation, as real domains can be close
+	// to common ones (e.g. "aon.com" and "aol.com"); use SuggestEmail to hint at a correction for valid addresses.
+	SuggestDomains []string
+}
+
+// CommonEmailDomains are the domains of widely used email providers, for EmailPolicy.SuggestDomains.
+var CommonEmailDomains = []string{
+	"gmail.com", "googlemail.com", "yahoo.com", "hotmail.com", "outlook.com", "live.com", "msn.com", "icloud.com",
+	"me.com", "mac.com", "aol.com", "protonmail.com", "proton.me", "gmx.com", "gmx.de", "mail.com", "yandex.com",
+	"zoho.com", "comcast.net", "verizon.net", "att.net", "web.de", "orange.fr", "yahoo.co.uk", "hotmail.co.uk",
+}
+
+// EmailWith appends the rules of Email, along with a rule for each requirement of the provided policy.
+// Requirements are only checked for valid email addresses, and each fails with its own code, so the
+// requirement that failed can be told apart.
+func (v *ValidatableString) EmailWith(policy EmailPolicy, msg ...string) *ValidatableString {
+	v.Email(msg...)
+	if policy.PlusAddressing == PlusStrip {
+		v.transforms = append(v.transforms, stripPlus)
+	}
+
+	suggestions := policy.SuggestDomains
+	if policy.RequireTLD {
+		v.emailRule(CodeEmailTLD, "RequireTLD", func(_, domain string) bool { return hasTLD(domain) }, suggestions, msg)
+	}
+	if len(policy.AllowDomains) > 0 {
+		v.emailRule(CodeEmailDomain, "AllowDomains", func(_, domain string) bool {
+			return domainIn(domain, policy.AllowDomains)
+		}, suggestions, msg)
+	}
+	if len(policy.DenyDomains) > 0 {
+		v.emailRule(CodeEmailDomain, "DenyDomains", func(_, domain string) bool {
+			return !domainIn(domain, policy.DenyDomains)
+		}, suggestions, msg)
+	}
+	if len(policy.DisposableDomains) > 0 {
+		disposable := make([]string, 0, 2*len(policy.DisposableDomains))
+		for _, domain := range policy.DisposableDomains {
+			disposable = append(disposable, domain, "*."+domain)
+		}
+		v.emailRule(CodeEmailDisposable, "DisposableDomains", func(_, domain string) bool {
+			return !domainIn(domain, disposable)
+		}, suggestions, msg)
+	}
+	if policy.LimitLengths {
+		v.emailRule(CodeEmailLength, "LimitLengths", func(local, domain string) bool {
+			return len(local) <= 64 && len(local)+1+len(domain) <= 254
+		}, suggestions, msg)
+	}
+	if policy.PlusAddressing == PlusReject {
+		v.emailRule(CodeEmailPlus, "PlusAddressing", func(local, _ string) bool {
+			return !strings.Contains(local, "+")
+		}, suggestions, msg)
+	}
+	return v
+}
+
+// emailRule appends a rule validating that valid email addresses meet the named requirement of an EmailPolicy.
+// If an address fails it and its domain is a typo of one of suggestions, the corrected address is suggested.
+func (v *ValidatableString) emailRule(code, requirement string, meets func(local, domain string) bool, suggestions []string, msg []string) {
+	name := "Email(" + requirement + ")"
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		// the params of the check, for the issue if it fails
+		var params map[string]any
+		return rule{code: code, check: func() string {
+			params = nil
+			local, domain, ok := splitEmail(v.value)
+			if !ok || meets(local, domain) {
+				return ""
+			}
+			var detail string
+			if suggestion, ok := SuggestEmail(v.value, suggestions); ok {
+				detail = fmt.Sprintf(" (did you mean %q?)", suggestion)
+				params = map[string]any{"suggestion": suggestion}
+			}
+			switch {
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <%s>%s", *v.tag, name, detail)
+			default:
+				return fmt.Sprintf("failed <string> validation for <%s>%s", name, detail)
+			}
+		}, params: func() map[string]any { return params }}
+	})
+}
+
+// SuggestEmail returns the address email was likely meant to be, if it's a valid address whose domain is close to,
+// but isn't, one of domains (e.g. CommonEmailDomains): "jo@gmial.com" suggests "jo@gmail.com". It's only a hint,
+// for asking users to double-check their address, as real domains can be close to common ones.
+func SuggestEmail(email string, domains []string) (string, bool) {
+	local, domain, ok := splitEmail(email)
+	if !ok || len(domains) == 0 || domainIn(domain, domains) {
+		return "", false
+	}
+	// domains are short and often alike (e.g. "me.com" and "msn.com"), so only allow a second
+	// edit for longer ones
+	maxDistance := 1
+	if len(domain) >= 12 {
+		maxDistance = 2
+	}
+	if suggestion, ok := internal.Closest(strings.ToLower(domain), domains, maxDistance); ok {
+		return local + "@" + suggestion, true
+	}
+	return "", false
+}
+
+func isEmail(s string) bool {
+	a, err := mail.ParseAddress(s)
+	return err == nil && a.Address == s
+}
+
+// splitEmail splits a valid email address into its local part and domain.
+func splitEmail(s string) (local, domain string, ok bool) {
+	if !isEmail(s) {
+		return "", "", false
+	}
+	at := strings.LastIndexByte(s, '@')
+	return s[:at], s[at+1:], true
+}
+
+func hasTLD(domain string) bool {
+	dot := strings.LastIndexByte(domain, '.')
+	if dot <= 0 {
+		return false
+	}
+	tld := domain[dot+1:]
+	if strings.HasPrefix(strings.ToLower(tld), "xn--") {
+		return len(tld) > 4
+	}
+	if len(tld) < 2 {
+		return false
+	}
+	for _, c := range tld {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// domainIn reports whether domain is one of domains, where "*.example.com" matches any subdomain of example.com.
+func domainIn(domain string, domains []string) bool {
+	domain = strings.ToLower(domain)
+	for _, d := range domains {
+		d = strings.ToLower(d)
+		if strings.HasPrefix(d, "*.") {
+			if strings.HasSuffix(domain, d[1:]) {
+				return true
+			}
+		} else if domain == d {
+			return true
+		}
+	}
+	return false
+}
+
+// stripPlus removes the "+tag" from the local part of a valid email address.
+func stripPlus(s string) string {
+	local, domain, ok := splitEmail(s)
+	if !ok {
+		return s
+	}
+	if plus := strings.IndexByte(local, '+'); plus > 0 {
+		return local[:plus] + "@" + domain
+	}
+	return s
+}
@@ -0,0 +1,395 @@
+package z
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// formats are the JSON Schema formats (as defined by draft 2020-12) of the codes of rules that validate them.
+var formats = map[string]string{
+	CodeUUID:     "uuid",
+	CodeURL:      "uri",
+	CodeIPv4:     "ipv4",
+	CodeIPv6:     "ipv6",
+	CodeHostname: "hostname",
+	CodeEmail:    "email",
+	CodeRFC3339:  "date-time",
+	CodeDate:     "date",
+}
+
+// FormatOf returns the JSON Schema "format" of the strings passing the rule whose code is code (e.g. "uri" for
+// CodeURL), for exporting schemas as JSON Schema. Reports false if the rule has no format of its own, such as
+// CodeIP, which accepts either of "ipv4" and "ipv6".
+func FormatOf(code string) (string, bool) {
+	format, ok := formats[code]
+	return format, ok
+}
+
+// UUID appends a rule validating that data is a UUID in its canonical, hyphenated form (of any version).
+func (v *ValidatableString) UUID(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeUUID, check: func() string {
+			switch {
+			case isUUID(v.value, 0):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <UUID>", *v.tag)
+			default:
+				return "failed <string> validation for <UUID>"
+			}
+		}}
+	})
+	return v
+}
+
+// UUIDVersion appends a rule validating that data is an RFC 4122 UUID of the provided version, in its canonical,
+// hyphenated form.
+func (v *ValidatableString) UUIDVersion(version int, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeUUID, check: func() string {
+			switch {
+			case isUUID(v.value, version):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <UUID(%d)>", *v.tag, version)
+			default:
+				return fmt.Sprintf("failed <string> validation for <UUID(%d)>", version)
+			}
+		}}
+	})
+	return v
+}
+
+// URL appends a rule validating that data is an absolute URL, with both a scheme and a host.
+func (v *ValidatableString) URL(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeURL, check: func() string {
+			switch {
+			case isURL(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <URL>", *v.tag)
+			default:
+				return "failed <string> validation for <URL>"
+			}
+		}}
+	})
+	return v
+}
+
+// URLScheme appends a rule validating that data is an absolute URL whose scheme is in the provided slice of schemes.
+// Schemes are compared case-insensitively.
+func (v *ValidatableString) URLScheme(schemes []string, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeURLScheme, check: func() string {
+			switch {
+			case isURL(v.value) && urlSchemeIn(v.value, schemes):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <URLScheme(%v)>", *v.tag, schemes)
+			default:
+				return fmt.Sprintf("failed <string> validation for <URLScheme(%v)>", schemes)
+			}
+		}}
+	})
+	return v
+}
+
+// URLHost appends a rule validating that data is an absolute URL whose host is in the provided slice of hosts.
+// Hosts are compared case-insensitively, and a host of the form "*.example.com" matches any subdomain of example.com.
+func (v *ValidatableString) URLHost(hosts []string, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeURLHost, check: func() string {
+			switch {
+			case isURL(v.value) && urlHostIn(v.value, hosts):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <URLHost(%v)>", *v.tag, hosts)
+			default:
+				return fmt.Sprintf("failed <string> validation for <URLHost(%v)>", hosts)
+			}
+		}}
+	})
+	return v
+}
+
+// IP appends a rule validating that data is an IPv4 or IPv6 address.
+func (v *ValidatableString) IP(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeIP, check: func() string {
+			switch {
+			case isIP(v.value, 0):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <IP>", *v.tag)
+			default:
+				return "failed <string> validation for <IP>"
+			}
+		}}
+	})
+	return v
+}
+
+// IPv4 appends a rule validating that data is an IPv4 address in dotted decimal form.
+func (v *ValidatableString) IPv4(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeIPv4, check: func() string {
+			switch {
+			case isIP(v.value, 4):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <IPv4>", *v.tag)
+			default:
+				return "failed <string> validation for <IPv4>"
+			}
+		}}
+	})
+	return v
+}
+
+// IPv6 appends a rule validating that data is an IPv6 address, optionally with a zone (e.g. "fe80::1%eth0").
+func (v *ValidatableString) IPv6(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeIPv6, check: func() string {
+			switch {
+			case isIP(v.value, 6):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <IPv6>", *v.tag)
+			default:
+				return "failed <string> validation for <IPv6>"
+			}
+		}}
+	})
+	return v
+}
+
+// CIDR appends a rule validating that data is an IPv4 or IPv6 prefix in CIDR notation (e.g. "10.0.0.0/8").
+func (v *ValidatableString) CIDR(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeCIDR, check: func() string {
+			switch {
+			case isCIDR(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <CIDR>", *v.tag)
+			default:
+				return "failed <string> validation for <CIDR>"
+			}
+		}}
+	})
+	return v
+}
+
+// Hostname appends a rule validating that data is a hostname under RFC 1123. Labels of letters, digits, and hyphens
+// (not starting or ending with one) are at most 63 characters long, and the hostname at most 253.
+func (v *ValidatableString) Hostname(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeHostname, check: func() string {
+			switch {
+			case isHostname(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Hostname>", *v.tag)
+			default:
+				return "failed <string> validation for <Hostname>"
+			}
+		}}
+	})
+	return v
+}
+
+// HostPort appends a rule validating that data is a host (a hostname, IPv4 address, or bracketed IPv6 address) and a
+// port separated by a colon (e.g. "example.com:443" or "[::1]:8080").
+func (v *ValidatableString) HostPort(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeHostPort, check: func() string {
+			switch {
+			case isHostPort(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <HostPort>", *v.tag)
+			default:
+				return "failed <string> validation for <HostPort>"
+			}
+		}}
+	})
+	return v
+}
+
+// MAC appends a rule validating that data is an IEEE 802 MAC-48, EUI-48, EUI-64, or 20-octet IP over InfiniBand
+// link-layer address, separated by colons, hyphens, or periods.
+func (v *ValidatableString) MAC(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeMAC, check: func() string {
+			switch {
+			case isMAC(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <MAC>", *v.tag)
+			default:
+				return "failed <string> validation for <MAC>"
+			}
+		}}
+	})
+	return v
+}
+
+// Port appends a rule validating that data is a port number between 1 and 65535, written in decimal.
+func (v *ValidatableString) Port(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodePort, check: func() string {
+			switch {
+			case isPort(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Port>", *v.tag)
+			default:
+				return "failed <string> validation for <Port>"
+			}
+		}}
+	})
+	return v
+}
+
+// isUUID reports whether s is a UUID in its canonical form. If version isn't zero, s must also be an
+// RFC 4122 UUID of that version.
+func isUUID(s string, version int) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(s[i]) {
+				return false
+			}
+		}
+	}
+	if version == 0 {
+		return true
+	}
+	return strconv.Itoa(version) == s[14:15] && strings.ContainsRune("89abAB", rune(s[19]))
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func urlSchemeIn(s string, schemes []string) bool {
+	u, _ := url.Parse(s)
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func urlHostIn(s string, hosts []string) bool {
+	u, _ := url.Parse(s)
+	return domainIn(u.Hostname(), hosts)
+}
+
+// isIP reports whether s is an IP address of the provided version (4 or 6), or of either version if it's zero.
+func isIP(s string, version int) bool {
+	addr, err := netip.ParseAddr(s)
+	switch {
+	case err != nil:
+		return false
+	case version == 4:
+		return addr.Is4()
+	case version == 6:
+		return addr.Is6()
+	default:
+		return true
+	}
+}
+
+func isCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isHostPort(s string) bool {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil || !isPort(port) {
+		return false
+	}
+	if strings.HasPrefix(s, "[") {
+		// brackets are only for IPv6 addresses
+		return isIP(host, 6)
+	}
+	return isIP(host, 4) || isHostname(host)
+}
+
+func isMAC(s string) bool {
+	_, err := net.ParseMAC(s)
+	return err == nil
+}
+
+func isPort(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	port, err := strconv.ParseUint(s, 10, 16)
+	return err == nil && port > 0
+}
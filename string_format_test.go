@@ -0,0 +1,29 @@
+package z
+
+import "testing"
+
+func TestFormatOf(t *testing.T) {
+	tests := []struct {
+		code   string
+		format string
+		ok     bool
+	}{
+		{CodeUUID, "uuid", true},
+		{CodeURL, "uri", true},
+		{CodeIPv4, "ipv4", true},
+		{CodeIPv6, "ipv6", true},
+		{CodeHostname, "hostname", true},
+		{CodeEmail, "email", true},
+		{CodeRFC3339, "date-time", true},
+		{CodeDate, "date", true},
+		{CodeIP, "", false},
+		{CodeCIDR, "", false},
+		{CodeMAC, "", false},
+		{"unknown", "", false},
+	}
+	for _, tt := range tests {
+		if format, ok := FormatOf(tt.code); format != tt.format || ok != tt.ok {
+			t.Errorf("FormatOf(%q) = %q, %v; want %q, %v", tt.code, format, ok, tt.format, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package z
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
+
+// Form is a Unicode normalization form.
+type Form int
+
+const (
+	// NFC composes characters, so "é" written as "e" and a combining accent becomes a single "é".
+	NFC Form = iota
+	// NFD decomposes characters, so "é" becomes "e" followed by a combining accent.
+	NFD
+	// NFKC composes characters, and also replaces compatibility characters with their plain
+	// equivalents (e.g. "ﬁ" becomes "fi", fullwidth "Ａ" becomes "A").
+	NFKC
+	// NFKD decomposes characters, and also replaces compatibility characters with their plain equivalents.
+	NFKD
+)
+
+func (f Form) normalize(s string) string {
+	switch f {
+	case NFD:
+		return norm.NFD.String(s)
+	case NFKC:
+		return norm.NFKC.String(s)
+	case NFKD:
+		return norm.NFKD.String(s)
+	default:
+		return norm.NFC.String(s)
+	}
+}
+
+// Normalize transforms data into the provided normalization form before any rule is run, so strings
+// that are written differently but are canonically (or, with NFKC and NFKD, compatibly) equivalent are
+// validated the same. Use WriteBack to write the normalized string back into data.
+func (v *ValidatableString) Normalize(form Form) *ValidatableString {
+	v.transforms = append(v.transforms, form.normalize)
+	return v
+}
+
+// NoInvisible appends a rule validating that data contains no invisible characters, such as zero width
+// spaces and joiners, bidirectional controls, soft hyphens, or variation selectors.
+func (v *ValidatableString) NoInvisible(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeNoInvisible, check: func() string {
+			switch {
+			case !strings.ContainsFunc(v.value, internal.IsInvisible):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <NoInvisible>", *v.tag)
+			default:
+				return "failed <string> validation for <NoInvisible>"
+			}
+		}}
+	})
+	return v
+}
+
+// NoMixedScripts appends a rule validating that data is written in a single script (e.g. not Latin mixed with
+// Cyrillic), ignoring characters shared between scripts such as digits and punctuation. As in the "highly
+// restrictive" level of Unicode Technical Standard #39, Latin may be mixed with the scripts of Japanese
+// (Han, Hiragana and Katakana), Chinese (Han and Bopomofo) or Korean (Han and Hangul).
+func (v *ValidatableString) NoMixedScripts(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeNoMixedScripts, check: func() string {
+			switch {
+			case !isMixedScripts(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <NoMixedScripts>", *v.tag)
+			default:
+				return "failed <string> validation for <NoMixedScripts>"
+			}
+		}}
+	})
+	return v
+}
+
+// SkeletonCtx appends a context-aware rule passed the Skeleton of data, so that it can be compared against the
+// skeletons of existing identifiers (e.g. usernames) to reject look-alikes. Validates if the provided function
+// returns nil. As with CustomCtx, the function fails validation by returning ErrInvalid (or an error wrapping
+// it); any other error stops validation and is returned separately by ValidateContext.
+func (v *ValidatableString) SkeletonCtx(fn func(ctx context.Context, skeleton string) error, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		var err error
+		return rule{code: CodeSkeleton, check: func() string {
+			err = fn(v.run.ctx, Skeleton(v.value))
+			switch {
+			case err == nil:
+				return ""
+			case !errors.Is(err, ErrInvalid):
+				v.run.fail(err)
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <SkeletonCtx>", *v.tag)
+			default:
+				return "failed <string> validation for <SkeletonCtx>"
+			}
+		}, cause: func() error { return err }}
+	})
+	return v
+}
+
+// Skeleton returns an approximation of the skeleton of s as described by Unicode Technical Standard #39. Strings
+// that look alike, such as "paypal" written with a Cyrillic "а" and "paypal" written in Latin, have equal skeletons.
+// Only a partial table of confusables is used: the Cyrillic, Greek, fullwidth and ASCII look-alikes most often used
+// to spoof Latin identifiers, rather than the thousands of mappings in the standard's confusables.txt, so look-alikes
+// from other scripts (e.g. Armenian "օ") aren't caught. Skeletons are only meant to be compared with each other, not
+// shown. They're case-sensitive, so lowercase identifiers
+// first if they're compared case-insensitively.
+func Skeleton(s string) string { return internal.Skeleton(s) }
+
+// scriptsOf names the scripts used in s, ignoring the Common and Inherited scripts.
+func scriptsOf(s string) map[string]bool {
+	scripts := make(map[string]bool)
+	for _, r := range s {
+		if unicode.In(r, unicode.Common, unicode.Inherited) {
+			continue
+		}
+		for name, table := range unicode.Scripts {
+			if unicode.Is(table, r) {
+				scripts[name] = true
+				break
+			}
+		}
+	}
+	return scripts
+}
+
+// combinedScripts are the sets of scripts that may be mixed within a single identifier.
+var combinedScripts = []map[string]bool{
+	{"Latin": true, "Han": true, "Hiragana": true, "Katakana": true},
+	{"Latin": true, "Han": true, "Bopomofo": true},
+	{"Latin": true, "Han": true, "Hangul": true},
+}
+
+func isMixedScripts(s string) bool {
+	scripts := scriptsOf(s)
+	if len(scripts) <= 1 {
+		return false
+	}
+	for _, combined := range combinedScripts {
+		covered := true
+		for script := range scripts {
+			covered = covered && combined[script]
+		}
+		if covered {
+			return false
+		}
+	}
+	return true
+}
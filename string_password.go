@@ -0,0 +1,202 @@
+package z
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy configures the rules appended by Password. Each requirement left at its zero value isn't checked.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters (runes) in the password.
+	MinLength int
+	// RequireLower, RequireUpper, RequireDigit, and RequireSymbol require at least one character of each class.
+	// Symbols are any characters that aren't letters or digits.
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// MinClasses is the minimum number of the above classes the password must draw from, whichever they are.
+	MinClasses int
+	// MinEntropy is the minimum estimated entropy of the password, in bits (see PasswordEntropy).
+	MinEntropy float64
+	// MaxRepeats is the longest run of a single repeated character allowed (e.g. 2 rejects "aaa").
+	MaxRepeats int
+	// MaxSequence is the longest run of consecutive characters allowed, ascending or descending
+	// (e.g. 3 rejects "abcd" and "4321").
+	MaxSequence int
+	// NotContaining are the tags of fields of the struct being validated (e.g. "username", "email") whose values
+	// the password mustn't contain, compared case-insensitively. Empty fields, and fields that aren't strings, are
+	// ignored. When validated outside a struct, this requirement is skipped.
+	NotContaining []string
+	// Breached is a list of breached or common passwords the password mustn't be (see LoadPasswordList).
+	Breached *PasswordList
+}
+
+// PasswordList is a set of passwords, such as those known to have been breached.
+type PasswordList struct {
+	passwords map[string]struct{}
+}
+
+// LoadPasswordList reads a PasswordList from r, one password per line. Surrounding whitespace and empty lines are
+// ignored.
+func LoadPasswordList(r io.Reader) (*PasswordList, error) {
+	list := &PasswordList{passwords: make(map[string]struct{})}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			list.passwords[password] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Contains reports whether password is in the list, either as is or lowercased,
+// as lists of common passwords are often lowercase.
+func (l *PasswordList) Contains(password string) bool {
+	if _, ok := l.passwords[password]; ok {
+		return true
+	}
+	_, ok := l.passwords[strings.ToLower(password)]
+	return ok
+}
+
+// Len returns the number of passwords in the list.
+func (l *PasswordList) Len() int { return len(l.passwords) }
+
+// Password appends a rule for each requirement of the provided policy. Each fails with its own code and names
+// the requirement in its message (e.g. "<Password(MinLength(12))>"), so the requirements that failed can be
+// told apart.
+func (v *ValidatableString) Password(policy PasswordPolicy, msg ...string) *ValidatableString {
+	if policy.MinLength > 0 {
+		v.requirement(CodePasswordLength, fmt.Sprintf("Password(MinLength(%d))", policy.MinLength), func(v *ValidatableString) bool {
+			return utf8.RuneCountInString(v.value) >= policy.MinLength
+		}, msg)
+	}
+	for _, class := range passwordClasses {
+		if class.required(policy) {
+			class := class
+			v.requirement(CodePasswordClasses, "Password("+class.name+")", func(v *ValidatableString) bool {
+				return strings.IndexFunc(v.value, class.is) >= 0
+			}, msg)
+		}
+	}
+	if policy.MinClasses > 0 {
+		v.requirement(CodePasswordClasses, fmt.Sprintf("Password(MinClasses(%d))", policy.MinClasses), func(v *ValidatableString) bool {
+			classes := 0
+			for _, class := range passwordClasses {
+				if strings.IndexFunc(v.value, class.is) >= 0 {
+					classes++
+				}
+			}
+			return classes >= policy.MinClasses
+		}, msg)
+	}
+	if policy.MinEntropy > 0 {
+		v.requirement(CodePasswordEntropy, fmt.Sprintf("Password(MinEntropy(%g))", policy.MinEntropy), func(v *ValidatableString) bool {
+			return PasswordEntropy(v.value) >= policy.MinEntropy
+		}, msg)
+	}
+	if policy.MaxRepeats > 0 {
+		v.requirement(CodePasswordRepeats, fmt.Sprintf("Password(MaxRepeats(%d))", policy.MaxRepeats), func(v *ValidatableString) bool {
+			return longestRun(v.value, func(prev, r rune) bool { return r == prev }) <= policy.MaxRepeats
+		}, msg)
+	}
+	if policy.MaxSequence > 0 {
+		v.requirement(CodePasswordSequence, fmt.Sprintf("Password(MaxSequence(%d))", policy.MaxSequence), func(v *ValidatableString) bool {
+			ascending := longestRun(v.value, func(prev, r rune) bool { return r == prev+1 })
+			descending := longestRun(v.value, func(prev, r rune) bool { return r == prev-1 })
+			return ascending <= policy.MaxSequence && descending <= policy.MaxSequence
+		}, msg)
+	}
+	for _, tag := range policy.NotContaining {
+		tag := tag
+		v.requirement(CodePasswordFields, "Password(NotContaining("+tag+"))", func(v *ValidatableString) bool {
+			field, ok := v.run.field(tag)
+			if s, isString := field.(string); ok && isString && s != "" {
+				return !strings.Contains(strings.ToLower(v.value), strings.ToLower(s))
+			}
+			return true
+		}, msg)
+	}
+	if policy.Breached != nil {
+		v.requirement(CodePasswordBreached, "Password(Breached)", func(v *ValidatableString) bool {
+			return !policy.Breached.Contains(v.value)
+		}, msg)
+	}
+	return v
+}
+
+// PasswordEntropy estimates the entropy of password in bits, as its length times the log2 of the size of the
+// character classes it draws from: 26 for lowercase letters, 26 for uppercase letters, 10 for digits, 33 for ASCII
+// symbols, and 100 for any other characters. It doesn't account for dictionary words or patterns, so should be
+// combined with the other requirements of a PasswordPolicy.
+func PasswordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case 'a' <= r && r <= 'z':
+			lower = true
+		case 'A' <= r && r <= 'Z':
+			upper = true
+		case '0' <= r && r <= '9':
+			digit = true
+		case r < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// passwordClass is a class of characters a PasswordPolicy may require.
+type passwordClass struct {
+	name     string
+	is       func(rune) bool
+	required func(PasswordPolicy) bool
+}
+
+var passwordClasses = []passwordClass{
+	{"RequireLower", unicode.IsLower, func(p PasswordPolicy) bool { return p.RequireLower }},
+	{"RequireUpper", unicode.IsUpper, func(p PasswordPolicy) bool { return p.RequireUpper }},
+	{"RequireDigit", unicode.IsDigit, func(p PasswordPolicy) bool { return p.RequireDigit }},
+	{"RequireSymbol", func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }, func(p PasswordPolicy) bool { return p.RequireSymbol }},
+}
+
+// longestRun returns the length of the longest run of runes in s where each follows the previous.
+func longestRun(s string, follows func(prev, r rune) bool) int {
+	longest, run := 0, 0
+	prev := rune(-1)
+	for _, r := range s {
+		if run > 0 && follows(prev, r) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		prev = r
+	}
+	return longest
+}
@@ -0,0 +1,748 @@
+package z
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSON appends a rule validating that data is a single, well-formed JSON value.
+// The byte offset, line and column where data stops being valid are included as params.
+func (v *ValidatableString) JSON(msg ...string) *ValidatableString {
+	v.diagnosis(CodeJSON, "JSON", func(v *ValidatableString) (string, map[string]any) {
+		if json.Valid([]byte(v.value)) {
+			return "", nil
+		}
+		var raw json.RawMessage
+		return jsonDetail(v.value, json.Unmarshal([]byte(v.value), &raw))
+	}, msg)
+	return v
+}
+
+// JSONOf appends a rule validating that data is JSON that decodes into the value returned by target (which must be
+// a pointer, and should be new on every call), and that the decoded value passes schema. The issues found by
+// schema are returned as they are, with paths beneath data's. JSONOf implies JSON.
+//
+//	z.String().JSONOf(func() any { return &Settings{} }, z.Struct{...})
+func (v *ValidatableString) JSONOf(target func() any, schema Validatable, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeJSON, issues: func() []Issue {
+			value := target()
+			if err := json.Unmarshal([]byte(v.value), value); err != nil {
+				detail, params := jsonDetail(v.value, err)
+				issue := Issue{Code: CodeJSON, Params: params}
+				switch {
+				case len(msg) > 0:
+					issue.Message = msg[0]
+				case v.tag != nil:
+					issue.Message = fmt.Sprintf("<%s> failed <string> validation for <JSON> (%s)", *v.tag, detail)
+				default:
+					issue.Message = fmt.Sprintf("failed <string> validation for <JSON> (%s)", detail)
+				}
+				if v.tag != nil {
+					issue.Path = *v.tag
+				}
+				return []Issue{issue}
+			}
+			// the issues are reported along with the string's own, so they mustn't count towards the run's error limit twice
+			var errs Errors
+			if v.tag != nil {
+				errs = v.run.untallied().walk(schema, value, *v.tag)
+			} else {
+				errs = v.run.untallied().walk(schema, value)
+			}
+			if errs == nil {
+				return nil
+			}
+			return errs.Issues()
+		}}
+	})
+	return v
+}
+
+// jsonDetail describes where err, returned while decoding s, happened.
+func jsonDetail(s string, err error) (string, map[string]any) {
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+		if strings.HasPrefix(syntaxErr.Error(), "invalid character") {
+			// the offset is that of the byte after the invalid character
+			offset--
+		}
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return err.Error(), nil
+	}
+	line, column := position(s, int(offset))
+	params := map[string]any{"offset": int(offset), "line": line, "column": column}
+	return fmt.Sprintf("%v at line %d, column %d", err, line, column), params
+}
+
+// position returns the line and column (in runes, both from 1) of the byte offset in s.
+func position(s string, offset int) (line, column int) {
+	if offset > len(s) {
+		offset = len(s)
+	}
+	line, column = 1, 1
+	for _, c := range s[:offset] {
+		if c == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return line, column
+}
+
+// Encoding is a binary-to-text encoding strings may be validated against.
+type Encoding int
+
+const (
+	// EncodingBase64 is standard base64 (RFC 4648), with padding.
+	EncodingBase64 Encoding = iota
+	// EncodingBase64URL is URL-safe base64 (RFC 4648), with or without padding.
+	EncodingBase64URL
+	// EncodingHex is hexadecimal, in either case.
+	EncodingHex
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case EncodingBase64:
+		return "Base64"
+	case EncodingBase64URL:
+		return "Base64URL"
+	case EncodingHex:
+		return "Hex"
+	default:
+		return "Encoding(" + strconv.Itoa(int(e)) + ")"
+	}
+}
+
+// decode decodes s, returning the byte offset of the first invalid byte of s if it can't be decoded.
+func (e Encoding) decode(s string) (decoded []byte, offset int, err error) {
+	switch e {
+	case EncodingHex:
+		for i := 0; i < len(s); i++ {
+			if !isHex(s[i]) {
+				return nil, i, fmt.Errorf("invalid hex digit %q", s[i])
+			}
+		}
+		if len(s)%2 != 0 {
+			return nil, len(s), errors.New("odd number of hex digits")
+		}
+		decoded = make([]byte, len(s)/2)
+		for i := range decoded {
+			n, _ := strconv.ParseUint(s[2*i:2*i+2], 16, 8)
+			decoded[i] = byte(n)
+		}
+		return decoded, 0, nil
+	case EncodingBase64, EncodingBase64URL:
+		// the decoders skip over newlines, which aren't valid within a single value
+		if i := strings.IndexAny(s, "\r\n"); i >= 0 {
+			return nil, i, fmt.Errorf("illegal base64 data at input byte %d", i)
+		}
+		encoding := base64.StdEncoding
+		if e == EncodingBase64URL {
+			encoding = base64.URLEncoding
+			if !strings.HasSuffix(s, "=") {
+				encoding = base64.RawURLEncoding
+			}
+		}
+		decoded, err = encoding.Strict().DecodeString(s)
+		var corrupt base64.CorruptInputError
+		if errors.As(err, &corrupt) {
+			return nil, int(corrupt), err
+		}
+		return decoded, 0, err
+	default:
+		return nil, 0, fmt.Errorf("unknown encoding %v", e)
+	}
+}
+
+// Base64 appends a rule validating that data is standard, padded base64.
+// The byte offset where data stops being valid is included as a param.
+func (v *ValidatableString) Base64(msg ...string) *ValidatableString {
+	return v.encoded(CodeBase64, EncodingBase64, msg)
+}
+
+// Base64URL appends a rule validating that data is URL-safe base64, with or without padding.
+// The byte offset where data stops being valid is included as a param.
+func (v *ValidatableString) Base64URL(msg ...string) *ValidatableString {
+	return v.encoded(CodeBase64, EncodingBase64URL, msg)
+}
+
+// Hex appends a rule validating that data is an even number of hexadecimal digits, in either case.
+// The byte offset where data stops being valid is included as a param.
+func (v *ValidatableString) Hex(msg ...string) *ValidatableString {
+	return v.encoded(CodeHex, EncodingHex, msg)
+}
+
+func (v *ValidatableString) encoded(code string, encoding Encoding, msg []string) *ValidatableString {
+	v.diagnosis(code, encoding.String(), func(v *ValidatableString) (string, map[string]any) {
+		if _, offset, err := encoding.decode(v.value); err != nil {
+			return fmt.Sprintf("invalid at offset %d", offset), map[string]any{"offset": offset}
+		}
+		return "", nil
+	}, msg)
+	return v
+}
+
+// DecodedLen appends a rule validating that data, once decoded from encoding, is min to max bytes long.
+// Data that can't be decoded passes the rule, so it should be combined with Base64, Base64URL or Hex.
+func (v *ValidatableString) DecodedLen(encoding Encoding, min, max int, msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeDecodedLen, check: func() string {
+			decoded, _, err := encoding.decode(v.value)
+			switch {
+			case err != nil || (len(decoded) >= min && len(decoded) <= max):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <DecodedLen(%v, %d, %d)>", *v.tag, encoding, min, max)
+			default:
+				return fmt.Sprintf("failed <string> validation for <DecodedLen(%v, %d, %d)>", encoding, min, max)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"encoding": encoding.String(), "min": min, "max": max}
+		}}
+	})
+	return v
+}
+
+// SemVer appends a rule validating that data is a semantic version, as of SemVer 2.0.0 (e.g. "1.4.0-rc.1+build.5").
+// The byte offset where data stops being valid is included as a param.
+func (v *ValidatableString) SemVer(msg ...string) *ValidatableString {
+	v.diagnosis(CodeSemVer, "SemVer", func(v *ValidatableString) (string, map[string]any) {
+		if _, _, err := parseSemVer(v.value, false); err != nil {
+			return err.Error(), map[string]any{"offset": err.offset}
+		}
+		return "", nil
+	}, msg)
+	return v
+}
+
+// SemVerRange appends a rule validating that data is a semantic version within constraint. A constraint is a list of
+// comparators that must all be met, such as ">=1.2 <2", and may offer alternatives separated by "||".
+// Comparators are made up of an operator (=, >, >=, <, <=, ~ or ^) and a version, which may be partial ("1.2") or
+// have wildcards ("1.x", "*"), with the same meaning as with npm. Versions are compared by SemVer precedence.
+//
+// As with npm, pre-release versions are only within a constraint if a comparator of the same alternative has a
+// pre-release on the same major, minor and patch version: ">=1.2.3-beta.1 <2" accepts "1.2.3-beta.2" but not
+// "1.2.4-beta.1", and ">=1.2 <2" accepts neither, nor "2.0.0-rc.1".
+//
+// An unparseable constraint is a schema error (see Err). Data that isn't a semantic version passes the rule,
+// so it should be combined with SemVer.
+func (v *ValidatableString) SemVerRange(constraint string, msg ...string) *ValidatableString {
+	alternatives, err := parseSemVerConstraint(constraint)
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("%w: SemVerRange(%s): %v", ErrInvalidSchema, constraint, err))
+		return v
+	}
+	v.requirement(CodeSemVerRange, "SemVerRange("+constraint+")", func(v *ValidatableString) bool {
+		version, _, err := parseSemVer(v.value, false)
+		if err != nil {
+			return true
+		}
+		for _, bounds := range alternatives {
+			if version.within(bounds) {
+				return true
+			}
+		}
+		return false
+	}, msg)
+	return v
+}
+
+// semverSyntaxError is an error parsing a semantic version or constraint at a byte offset.
+type semverSyntaxError struct {
+	offset int
+	reason string
+}
+
+func (e *semverSyntaxError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.reason, e.offset)
+}
+
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// parseSemVer parses s as a semantic version. If partial is set, versions may be missing their minor and patch
+// versions, or have wildcards (x, X or *) in their place; parts is then the number of versions present.
+func parseSemVer(s string, partial bool) (v semver, parts int, err *semverSyntaxError) {
+	fail := func(i int, reason string) (semver, int, *semverSyntaxError) {
+		if i >= len(s) {
+			return semver{}, 0, &semverSyntaxError{offset: i, reason: reason + ", found end"}
+		}
+		return semver{}, 0, &semverSyntaxError{offset: i, reason: fmt.Sprintf("%s, found %q", reason, s[i])}
+	}
+	nums := [3]*uint64{&v.major, &v.minor, &v.patch}
+	i := 0
+	for parts < 3 {
+		if parts > 0 {
+			if partial && i == len(s) {
+				return v, parts, nil
+			}
+			if i >= len(s) || s[i] != '.' {
+				return fail(i, `expected "."`)
+			}
+			i++
+		}
+		if partial && i < len(s) && strings.IndexByte("xX*", s[i]) >= 0 {
+			// the rest of the version must be wildcards as well
+			for i++; i+1 < len(s) && s[i] == '.' && strings.IndexByte("xX*", s[i+1]) >= 0; i += 2 {
+			}
+			if i != len(s) {
+				return fail(i, "expected end after wildcard")
+			}
+			return v, parts, nil
+		}
+		start := i
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return fail(i, "expected number")
+		}
+		if s[start] == '0' && i-start > 1 {
+			return fail(start, "unexpected leading zero")
+		}
+		n, parseErr := strconv.ParseUint(s[start:i], 10, 64)
+		if parseErr != nil {
+			return fail(start, "number too large")
+		}
+		*nums[parts] = n
+		parts++
+	}
+	if i < len(s) && s[i] == '-' {
+		for i++; ; i++ {
+			start := i
+			for i < len(s) && isSemVerIdentifier(s[i]) {
+				i++
+			}
+			if i == start {
+				return fail(i, "expected pre-release identifier")
+			}
+			id := s[start:i]
+			if isDigits(id) && id[0] == '0' && len(id) > 1 {
+				return fail(start, "unexpected leading zero")
+			}
+			v.pre = append(v.pre, id)
+			if i >= len(s) || s[i] != '.' {
+				break
+			}
+		}
+	}
+	if i < len(s) && s[i] == '+' {
+		for i++; ; i++ {
+			start := i
+			for i < len(s) && isSemVerIdentifier(s[i]) {
+				i++
+			}
+			if i == start {
+				return fail(i, "expected build identifier")
+			}
+			if i >= len(s) || s[i] != '.' {
+				break
+			}
+		}
+	}
+	if i != len(s) {
+		return fail(i, "expected end")
+	}
+	return v, parts, nil
+}
+
+func isSemVerIdentifier(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-'
+}
+
+// compare returns -1, 0 or 1 as v precedes, equals or follows w.
+func (v semver) compare(w semver) int {
+	for _, pair := range [][2]uint64{{v.major, w.major}, {v.minor, w.minor}, {v.patch, w.patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	// a version without a pre-release follows those with one
+	switch {
+	case len(v.pre) == 0 && len(w.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(w.pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(w.pre); i++ {
+		a, b := v.pre[i], w.pre[i]
+		if a == b {
+			continue
+		}
+		aNum, bNum := isDigits(a), isDigits(b)
+		switch {
+		case aNum && bNum:
+			// neither has leading zeros, so the longer is the larger
+			if len(a) != len(b) {
+				if len(a) < len(b) {
+					return -1
+				}
+				return 1
+			}
+		case aNum:
+			return -1
+		case bNum:
+			return 1
+		}
+		if a < b {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case len(v.pre) < len(w.pre):
+		return -1
+	case len(v.pre) > len(w.pre):
+		return 1
+	}
+	return 0
+}
+
+// semverBound is a single comparison a version must pass (e.g. ">= 1.2.0").
+type semverBound struct {
+	op      string
+	version semver
+}
+
+func (v semver) within(bounds []semverBound) bool {
+	if !v.passes(bounds) {
+		return false
+	}
+	if len(v.pre) == 0 {
+		return true
+	}
+	// pre-releases are only opted into for the version a bound gives a pre-release of
+	for _, b := range bounds {
+		if len(b.version.pre) > 0 && b.version.major == v.major && b.version.minor == v.minor && b.version.patch == v.patch {
+			return true
+		}
+	}
+	return false
+}
+
+// passes reports whether v passes every one of bounds.
+func (v semver) passes(bounds []semverBound) bool {
+	for _, b := range bounds {
+		c := v.compare(b.version)
+		switch b.op {
+		case ">":
+			if c <= 0 {
+				return false
+			}
+		case ">=":
+			if c < 0 {
+				return false
+			}
+		case "<":
+			if c >= 0 {
+				return false
+			}
+		case "<=":
+			if c > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// parseSemVerConstraint parses constraint into alternatives, each a list of bounds a version must all pass.
+func parseSemVerConstraint(constraint string) ([][]semverBound, error) {
+	var alternatives [][]semverBound
+	offset := 0
+	for _, alternative := range strings.Split(constraint, "||") {
+		var bounds []semverBound
+		comparators := 0
+		for i := 0; i < len(alternative); {
+			if alternative[i] == ' ' || alternative[i] == '\t' {
+				i++
+				continue
+			}
+			end := strings.IndexAny(alternative[i:], " \t")
+			if end < 0 {
+				end = len(alternative)
+			} else {
+				end += i
+			}
+			comparator, err := parseSemVerComparator(alternative[i:end])
+			if err != nil {
+				err.offset += offset + i
+				return nil, err
+			}
+			bounds = append(bounds, comparator...)
+			comparators++
+			i = end
+		}
+		if comparators == 0 {
+			return nil, &semverSyntaxError{offset: offset, reason: "expected comparator"}
+		}
+		alternatives = append(alternatives, bounds)
+		offset += len(alternative) + len("||")
+	}
+	return alternatives, nil
+}
+
+// parseSemVerComparator parses a comparator (e.g. "^1.2") into the bounds it sets.
+func parseSemVerComparator(s string) ([]semverBound, *semverSyntaxError) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(s, candidate) {
+			op = candidate
+			break
+		}
+	}
+	v, parts, err := parseSemVer(s[len(op):], true)
+	if err != nil {
+		err.offset += len(op)
+		return nil, err
+	}
+	if parts < 3 && len(v.pre) > 0 {
+		return nil, &semverSyntaxError{offset: len(op), reason: "unexpected pre-release on partial version"}
+	}
+	// upper returns the smallest version past those matching v up to (and including) the part at index
+	upper := func(index int) semver {
+		switch index {
+		case 0:
+			return semver{major: v.major + 1}
+		case 1:
+			return semver{major: v.major, minor: v.minor + 1}
+		default:
+			return semver{major: v.major, minor: v.minor, patch: v.patch + 1}
+		}
+	}
+	// below returns the bound excluding the versions from upper(index) on, including its pre-releases, as npm
+	// does by comparing against its lowest pre-release (e.g. "<2" is "<2.0.0-0")
+	below := func(index int) semverBound {
+		bound := upper(index)
+		bound.pre = []string{"0"}
+		return semverBound{"<", bound}
+	}
+	switch {
+	case parts == 0 && op == "<":
+		return []semverBound{{"<", semver{pre: []string{"0"}}}}, nil
+	case parts == 0 && op == ">":
+		// nothing follows every version
+		return []semverBound{{"<", semver{pre: []string{"0"}}}}, nil
+	case parts == 0:
+		return nil, nil
+	}
+	switch op {
+	case "", "=":
+		if parts == 3 {
+			return []semverBound{{">=", v}, {"<=", v}}, nil
+		}
+		return []semverBound{{">=", v}, below(parts - 1)}, nil
+	case ">":
+		if parts == 3 {
+			return []semverBound{{">", v}}, nil
+		}
+		return []semverBound{{">=", upper(parts - 1)}}, nil
+	case ">=":
+		return []semverBound{{">=", v}}, nil
+	case "<":
+		if parts == 3 {
+			return []semverBound{{"<", v}}, nil
+		}
+		v.pre = []string{"0"}
+		return []semverBound{{"<", v}}, nil
+	case "<=":
+		if parts == 3 {
+			return []semverBound{{"<=", v}}, nil
+		}
+		return []semverBound{below(parts - 1)}, nil
+	case "~":
+		// ~1 allows minor versions, ~1.2 and ~1.2.3 only patch versions
+		if parts == 1 {
+			return []semverBound{{">=", v}, below(0)}, nil
+		}
+		return []semverBound{{">=", v}, below(1)}, nil
+	default:
+		// ^ allows changes that don't modify the left-most non-zero version
+		index := 2
+		if v.major != 0 || parts == 1 {
+			index = 0
+		} else if v.minor != 0 || parts == 2 {
+			index = 1
+		}
+		return []semverBound{{">=", v}, below(index)}, nil
+	}
+}
+
+// Cron appends a rule validating that data is a cron expression: five fields (minute, hour, day of month, month and
+// day of week), or six with a leading seconds field. Fields may be "*", values, ranges ("1-5"), steps ("*/15",
+// "0-30/5") and lists of these ("1,15"). Months and days of the week may be given by name ("JAN", "mon"), days of
+// the week run from 0 to 7 (both Sunday), and "?" may stand in for either day field. The macros @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight and @hourly are accepted as well.
+//
+// The field and byte offset where data stops being valid are included as params.
+func (v *ValidatableString) Cron(msg ...string) *ValidatableString {
+	v.diagnosis(CodeCron, "Cron", func(v *ValidatableString) (string, map[string]any) {
+		if err := parseCron(v.value); err != nil {
+			return err.Error(), map[string]any{"field": err.field, "offset": err.offset}
+		}
+		return "", nil
+	}, msg)
+	return v
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	cronSeconds    = cronField{name: "second", min: 0, max: 59}
+	cronMinutes    = cronField{name: "minute", min: 0, max: 59}
+	cronHours      = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonths     = cronField{name: "month", min: 1, max: 12, names: []string{
+		"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC",
+	}}
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: []string{
+		"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT",
+	}}
+)
+
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// cronSyntaxError is an error parsing a cron expression at a byte offset, within field (if any).
+type cronSyntaxError struct {
+	field  string
+	offset int
+	reason string
+}
+
+func (e *cronSyntaxError) Error() string {
+	if e.field == "" {
+		return fmt.Sprintf("%s at offset %d", e.reason, e.offset)
+	}
+	return fmt.Sprintf("%s in %s at offset %d", e.reason, e.field, e.offset)
+}
+
+func parseCron(s string) *cronSyntaxError {
+	if strings.HasPrefix(s, "@") {
+		for _, macro := range cronMacros {
+			if s == macro {
+				return nil
+			}
+		}
+		return &cronSyntaxError{offset: 0, reason: fmt.Sprintf("unknown macro %q", s)}
+	}
+
+	// split s into fields, remembering where each starts
+	var fields []string
+	var offsets []int
+	for i := 0; i < len(s); {
+		if s[i] == ' ' || s[i] == '\t' {
+			i++
+			continue
+		}
+		end := strings.IndexAny(s[i:], " \t")
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += i
+		}
+		fields = append(fields, s[i:end])
+		offsets = append(offsets, i)
+		i = end
+	}
+
+	specs := []cronField{cronMinutes, cronHours, cronDayOfMonth, cronMonths, cronDayOfWeek}
+	switch len(fields) {
+	case 5:
+	case 6:
+		specs = append([]cronField{cronSeconds}, specs...)
+	default:
+		return &cronSyntaxError{offset: len(s), reason: fmt.Sprintf("expected 5 or 6 fields, found %d", len(fields))}
+	}
+	for i, field := range fields {
+		if err := specs[i].parse(field); err != nil {
+			err.offset += offsets[i]
+			return err
+		}
+	}
+	return nil
+}
+
+// parse parses s as a value of the field, returning an error with an offset within s.
+func (f cronField) parse(s string) *cronSyntaxError {
+	fail := func(offset int, reason string) *cronSyntaxError {
+		return &cronSyntaxError{field: f.name, offset: offset, reason: reason}
+	}
+	if s == "?" {
+		if f.name != cronDayOfMonth.name && f.name != cronDayOfWeek.name {
+			return fail(0, `unexpected "?"`)
+		}
+		return nil
+	}
+	offset := 0
+	for _, item := range strings.Split(s, ",") {
+		rangePart, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n <= 0 || n > f.max {
+				return fail(offset+len(rangePart)+1, fmt.Sprintf("invalid step %q", step))
+			}
+		}
+		if rangePart != "*" {
+			low, high, isRange := strings.Cut(rangePart, "-")
+			lowValue, err := f.value(low, offset)
+			if err != nil {
+				return err
+			}
+			if isRange {
+				highValue, err := f.value(high, offset+len(low)+1)
+				if err != nil {
+					return err
+				}
+				if highValue < lowValue {
+					return fail(offset, fmt.Sprintf("range %q runs backwards", rangePart))
+				}
+			}
+		}
+		offset += len(item) + 1
+	}
+	return nil
+}
+
+// value parses s as a single value of the field, found at offset.
+func (f cronField) value(s string, offset int) (int, *cronSyntaxError) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	switch {
+	case s == "" || err != nil || !isDigits(s):
+		return 0, &cronSyntaxError{field: f.name, offset: offset, reason: fmt.Sprintf("invalid value %q", s)}
+	case n < f.min || n > f.max:
+		return 0, &cronSyntaxError{field: f.name, offset: offset, reason: fmt.Sprintf("value %d out of range %d-%d", n, f.min, f.max)}
+	}
+	return n, nil
+}
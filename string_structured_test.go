@@ -0,0 +1,63 @@
+package z
+
+import "testing"
+
+func TestSemVerRange(t *testing.T) {
+	tests := []struct {
+		constraint, version string
+		want                bool
+	}{
+		{">=1.2 <2", "1.5.0", true},
+		{">=1.2 <2", "2.0.0", false},
+		// pre-releases are only accepted when a comparator opts into them, as with npm
+		{">=1.2 <2", "1.5.0-beta", false},
+		{">=1.2 <2", "2.0.0-rc.1", false},
+		{"<2", "2.0.0-alpha", false},
+		{"*", "1.0.0", true},
+		{"*", "1.0.0-beta", false},
+		{">=1.2.3-beta.1 <2", "1.2.3-beta.2", true},
+		{">=1.2.3-beta.1 <2", "1.2.4-beta.1", false},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true},
+		{"^1.2.3-beta.2", "1.2.3", true},
+		{"^1.2.3-beta.2", "2.0.0-0", false},
+		{">1.0.0-alpha", "1.0.0-beta", true},
+		{"<1.0.0-rc.1", "1.0.0-beta", true},
+		{"1.2.3-alpha.1", "1.2.3-alpha.1", true},
+		{"~1.2", "1.2.9", true},
+		{"~1.2", "1.3.0-0", false},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0-alpha", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"1.x || >=2.5.0", "1.9.9", true},
+		{"1.x || >=2.5.0", "2.4.0", false},
+	}
+	for _, tt := range tests {
+		errs := String().SemVerRange(tt.constraint).Validate(tt.version)
+		if got := errs == nil; got != tt.want {
+			t.Errorf("SemVerRange(%q).Validate(%q) passed = %v, want %v", tt.constraint, tt.version, got, tt.want)
+		}
+	}
+}
+
+// A schema nested within itself, through JSONOf and Lazy, validates the nested value while validating its own.
+func TestJSONOfRecursive(t *testing.T) {
+	type node struct {
+		Name  string  `json:"name" z:"name"`
+		Child *string `json:"child" z:"child"`
+	}
+	var schema Struct
+	schema = Struct{
+		"name":  String().NotEmpty(),
+		"child": String().Optional().JSONOf(func() any { return &node{} }, Lazy(func() Validatable { return schema })),
+	}
+	child := `{"name": "b", "child": "{\"name\": \"c\"}"}`
+	if errs := schema.Validate(node{Name: "a", Child: &child}); errs != nil {
+		t.Errorf("Validate = %q, want no errors", errs.All())
+	}
+	child = `{"name": "b", "child": "{\"name\": \"\"}"}`
+	errs := schema.Validate(node{Name: "a", Child: &child})
+	if issues := errs.Issues(); len(issues) != 1 || issues[0].Path != "child.child.name" {
+		t.Errorf("Validate = %+v, want one issue at child.child.name", issues)
+	}
+}
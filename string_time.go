@@ -0,0 +1,167 @@
+package z
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout of ISO 8601 calendar dates, as validated by Date.
+const DateLayout = "2006-01-02"
+
+// parsedTime caches the time parsed from a value, so the rules of a schema parse it once.
+type parsedTime struct {
+	from   string
+	layout string
+	time   time.Time
+	err    error
+	set    bool
+}
+
+// time parses the value being validated with the schema's layout.
+func (v *ValidatableString) time() (time.Time, error) {
+	if !v.parsed.set || v.parsed.from != v.value || v.parsed.layout != v.layout {
+		t, err := time.Parse(v.layout, v.value)
+		v.parsed = parsedTime{from: v.value, layout: v.layout, time: t, err: err, set: true}
+	}
+	return v.parsed.time, v.parsed.err
+}
+
+// RFC3339 appends a rule validating that data is an RFC 3339 timestamp, with optional fractional seconds
+// (e.g. "2024-05-01T13:45:00Z", "2024-05-01T13:45:00.5+02:00"). Before, After and ParseTime use its layout.
+func (v *ValidatableString) RFC3339(msg ...string) *ValidatableString {
+	return v.timeLayout(CodeRFC3339, "RFC3339", time.RFC3339Nano, msg)
+}
+
+// Date appends a rule validating that data is an ISO 8601 calendar date (e.g. "2024-05-01"), as UTC.
+// Before, After and ParseTime use its layout.
+func (v *ValidatableString) Date(msg ...string) *ValidatableString {
+	return v.timeLayout(CodeDate, "Date", DateLayout, msg)
+}
+
+// Layout appends a rule validating that data is a time in layout, as understood by time.Parse.
+// Before, After and ParseTime use its layout.
+func (v *ValidatableString) Layout(layout string, msg ...string) *ValidatableString {
+	return v.timeLayout(CodeLayout, "Layout("+layout+")", layout, msg)
+}
+
+func (v *ValidatableString) timeLayout(code, name, layout string, msg []string) *ValidatableString {
+	v.layout = layout
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: code, check: func() string {
+			// the layout is the rule's own, rather than the schema's, which a later rule may have replaced
+			var err error
+			if layout == v.layout {
+				_, err = v.time()
+			} else {
+				_, err = time.Parse(layout, v.value)
+			}
+			switch {
+			case err == nil:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <%s>", *v.tag, name)
+			default:
+				return fmt.Sprintf("failed <string> validation for <%s>", name)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"layout": layout}
+		}}
+	})
+	return v
+}
+
+// Timezone appends a rule validating that data is the name of an IANA time zone (e.g. "Europe/Berlin", "UTC"),
+// as known to time.LoadLocation. "Local" isn't accepted, as its meaning depends on the machine.
+func (v *ValidatableString) Timezone(msg ...string) *ValidatableString {
+	v.requirement(CodeTimezone, "Timezone", func(v *ValidatableString) bool {
+		if v.value == "" || v.value == "Local" {
+			return false
+		}
+		_, err := time.LoadLocation(v.value)
+		return err == nil
+	}, msg)
+	return v
+}
+
+// Before appends a rule validating that data is a time before t. It must follow RFC3339, Date or Layout,
+// whose layout it parses data with. Data that can't be parsed passes the rule, leaving it to the layout's rule.
+func (v *ValidatableString) Before(t time.Time, msg ...string) *ValidatableString {
+	return v.timeRange(CodeBefore, "Before("+t.Format(time.RFC3339Nano)+")", func() time.Time { return t }, -1, msg)
+}
+
+// After appends a rule validating that data is a time after t. It must follow RFC3339, Date or Layout,
+// whose layout it parses data with. Data that can't be parsed passes the rule, leaving it to the layout's rule.
+func (v *ValidatableString) After(t time.Time, msg ...string) *ValidatableString {
+	return v.timeRange(CodeAfter, "After("+t.Format(time.RFC3339Nano)+")", func() time.Time { return t }, 1, msg)
+}
+
+// BeforeNow appends a rule validating that data is a time before the time it's validated at. See Before.
+func (v *ValidatableString) BeforeNow(msg ...string) *ValidatableString {
+	return v.timeRange(CodeBefore, "BeforeNow", time.Now, -1, msg)
+}
+
+// AfterNow appends a rule validating that data is a time after the time it's validated at. See After.
+func (v *ValidatableString) AfterNow(msg ...string) *ValidatableString {
+	return v.timeRange(CodeAfter, "AfterNow", time.Now, 1, msg)
+}
+
+// timeRange appends a rule validating that data compares to bound as sign (-1 for before, 1 for after).
+func (v *ValidatableString) timeRange(code, name string, bound func() time.Time, sign int, msg []string) *ValidatableString {
+	if v.layout == "" {
+		v.errs = append(v.errs, fmt.Errorf("%w: %s must follow RFC3339, Date or Layout", ErrInvalidSchema, name))
+		return v
+	}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		// the bound of the last check, so the params report the same time as the message
+		var last time.Time
+		return rule{code: code, check: func() string {
+			t, err := v.time()
+			last = bound()
+			switch {
+			case err != nil || (sign < 0 && t.Before(last)) || (sign > 0 && t.After(last)):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <%s>", *v.tag, name)
+			default:
+				return fmt.Sprintf("failed <string> validation for <%s>", name)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"time": last}
+		}}
+	})
+	return v
+}
+
+// ParseTime validates data against the schema, as Validate does, and returns the time it holds, parsed with the
+// layout of RFC3339, Date or Layout (whichever was added last). The time is zero if data is unset or fails
+// validation. If validation fails and the schema has a fallback (see Catch), the fallback is parsed instead, so the
+// time is also zero if the fallback isn't a time in the layout.
+//
+//	Returns Errors if:
+//	=> the schema is invalid (see Err), or has no layout
+//	=> data is not a string
+//	=> data fails any of the schema's rules
+func (v *ValidatableString) ParseTime(data any, tag ...string) (time.Time, Errors) {
+	var parsed time.Time
+	errs := validate(walkFunc(func(r *run, data any, tags ...string) Errors {
+		if v.layout == "" {
+			r.fail(fmt.Errorf("%w: ParseTime requires RFC3339, Date or Layout", ErrInvalidSchema))
+			return nil
+		}
+		c := *v
+		errs := c.walkCopy(r, data, tags...)
+		if errs != nil || r.err() != nil {
+			return errs
+		}
+		// the copy holds the validated value or, if the rules were swallowed by it, the fallback, which may not parse
+		if t, err := c.time(); err == nil {
+			parsed = t
+		}
+		return errs
+	}), data, tag...)
+	return parsed, errs
+}
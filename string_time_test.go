@@ -0,0 +1,43 @@
+package z
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	unset := (*string)(nil)
+	invalid := "2024-13-01"
+
+	tests := []struct {
+		name   string
+		schema *ValidatableString
+		data   any
+		want   time.Time
+		// errs is whether Errors are returned
+		errs bool
+	}{
+		{"valid", String().Date(), "2024-05-01", date(2024, 5, 1), false},
+		{"invalid", String().Date(), "2024-13-01", time.Time{}, true},
+		{"failing rule", String().Date().Before(date(2024, 1, 1)), "2024-05-01", time.Time{}, true},
+		{"not a string", String().Date(), 20240501, time.Time{}, true},
+		{"optional, unset", String().Date().Optional(), unset, time.Time{}, false},
+		{"optional, nil", String().Date().Optional(), nil, time.Time{}, false},
+		{"fallback taken for an invalid time", String().Date().Catch("2000-01-01"), "2024-13-01", date(2000, 1, 1), false},
+		{"fallback taken for a pointer", String().Date().Catch("2000-01-01"), &invalid, date(2000, 1, 1), false},
+		{"fallback taken for a failing rule", String().Date().After(date(2025, 1, 1)).Catch("2000-01-01"), "2024-05-01", date(2000, 1, 1), false},
+		{"fallback taken for another type", String().Date().Catch("2000-01-01"), 20240501, date(2000, 1, 1), false},
+		{"fallback not taken", String().Date().Catch("2000-01-01"), "2024-05-01", date(2024, 5, 1), false},
+		{"fallback that isn't a time", String().Date().Catch("never"), "2024-13-01", time.Time{}, false},
+		{"no layout", String(), "2024-05-01", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, errs := tt.schema.ParseTime(tt.data)
+		if !got.Equal(tt.want) || (errs != nil) != tt.errs {
+			t.Errorf("%s: ParseTime(%v) = %v, %v; want %v, errors %v", tt.name, tt.data, got, errs, tt.want, tt.errs)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package z
+
+import (
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Unit is a unit strings are measured in.
+type Unit int
+
+const (
+	// Bytes counts the bytes of a string's UTF-8 encoding, as len does.
+	Bytes Unit = iota
+	// Runes counts the Unicode code points of a string, as utf8.RuneCountInString does.
+	Runes
+	// Graphemes counts the user-perceived characters of a string, so an emoji made up of several
+	// code points, or a letter followed by a combining accent, counts as one. So do consonants joined
+	// into a conjunct by a virama (as in the Hindi "स्ते"), in the scripts Unicode 15.1 joins them in:
+	// Devanagari, Bengali, Gujarati, Oriya, Telugu and Malayalam. Emoji are recognized by the blocks
+	// they're drawn from, an approximation of Unicode's Extended_Pictographic property.
+	Graphemes
+)
+
+// CountBy sets the unit Min and Max measure data's length in. Defaults to Bytes.
+func (v *ValidatableString) CountBy(unit Unit) *ValidatableString {
+	v.unit = unit
+	return v
+}
+
+// length returns the length of data, measured in the schema's unit.
+func (v *ValidatableString) length() int {
+	switch v.unit {
+	case Runes:
+		return utf8.RuneCountInString(v.value)
+	case Graphemes:
+		return internal.GraphemeCount(v.value)
+	default:
+		return len(v.value)
+	}
+}
+
+// ASCII appends a rule validating that data only contains ASCII characters.
+func (v *ValidatableString) ASCII(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeASCII, check: func() string {
+			switch {
+			case isASCII(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <ASCII>", *v.tag)
+			default:
+				return "failed <string> validation for <ASCII>"
+			}
+		}}
+	})
+	return v
+}
+
+// Printable appends a rule validating that data only contains printable characters: letters, marks, numbers,
+// punctuation, symbols, and the ASCII space (as defined by unicode.IsPrint).
+func (v *ValidatableString) Printable(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodePrintable, check: func() string {
+			switch {
+			case allRunes(v.value, unicode.IsPrint):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Printable>", *v.tag)
+			default:
+				return "failed <string> validation for <Printable>"
+			}
+		}}
+	})
+	return v
+}
+
+// NoControlChars appends a rule validating that data contains no control characters (including tabs and newlines).
+func (v *ValidatableString) NoControlChars(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeNoControlChars, check: func() string {
+			switch {
+			case !strings.ContainsFunc(v.value, unicode.IsControl):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <NoControlChars>", *v.tag)
+			default:
+				return "failed <string> validation for <NoControlChars>"
+			}
+		}}
+	})
+	return v
+}
+
+// Alpha appends a rule validating that data only contains letters, in any script, and the marks combining with them.
+func (v *ValidatableString) Alpha(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeAlpha, check: func() string {
+			switch {
+			case allRunes(v.value, isAlpha):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Alpha>", *v.tag)
+			default:
+				return "failed <string> validation for <Alpha>"
+			}
+		}}
+	})
+	return v
+}
+
+// Alphanumeric appends a rule validating that data only contains letters and decimal digits, in any script, and the
+// marks combining with them.
+func (v *ValidatableString) Alphanumeric(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeAlphanumeric, check: func() string {
+			switch {
+			case allRunes(v.value, isAlphanumeric):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Alphanumeric>", *v.tag)
+			default:
+				return "failed <string> validation for <Alphanumeric>"
+			}
+		}}
+	})
+	return v
+}
+
+// Scripts appends a rule validating that data only contains characters from the provided scripts, named as in
+// unicode.Scripts (e.g. "Latin", "Cyrillic", "Han"). Characters shared between scripts, such as digits, punctuation
+// and combining marks (the "Common" and "Inherited" scripts), are always allowed. An unknown script name makes the
+// schema invalid (see Err).
+func (v *ValidatableString) Scripts(scripts []string, msg ...string) *ValidatableString {
+	tables := make([]*unicode.RangeTable, 0, len(scripts)+2)
+	for _, script := range append([]string{"Common", "Inherited"}, scripts...) {
+		table, ok := unicode.Scripts[script]
+		if !ok {
+			v.errs = append(v.errs, fmt.Errorf("%w: Scripts(%v): unknown script %q", ErrInvalidSchema, scripts, script))
+			return v
+		}
+		tables = append(tables, table)
+	}
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeScripts, check: func() string {
+			switch {
+			case inScripts(v.value, tables):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <Scripts(%v)>", *v.tag, scripts)
+			default:
+				return fmt.Sprintf("failed <string> validation for <Scripts(%v)>", scripts)
+			}
+		}}
+	})
+	return v
+}
+
+// UTF8 appends a rule validating that data is valid UTF-8.
+func (v *ValidatableString) UTF8(msg ...string) *ValidatableString {
+	v.rules = append(v.rules, func(v *ValidatableString) rule {
+		return rule{code: CodeUTF8, check: func() string {
+			switch {
+			case utf8.ValidString(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <string> validation for <UTF8>", *v.tag)
+			default:
+				return "failed <string> validation for <UTF8>"
+			}
+		}}
+	})
+	return v
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// allRunes reports whether every rune of s satisfies f. Invalid UTF-8 never does.
+func allRunes(s string, f func(rune) bool) bool {
+	for i, r := range s {
+		if r == utf8.RuneError && !strings.HasPrefix(s[i:], string(utf8.RuneError)) {
+			return false
+		}
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(r rune) bool { return unicode.IsLetter(r) || unicode.IsMark(r) }
+
+func isAlphanumeric(r rune) bool { return isAlpha(r) || unicode.IsDigit(r) }
+
+func inScripts(s string, tables []*unicode.RangeTable) bool {
+	return allRunes(s, func(r rune) bool { return unicode.IsOneOf(tables, r) })
+}
@@ -1,12 +1,16 @@
 package z
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/MarcusSanchez/go-z/internal"
 	"reflect"
 	"slices"
+	"sync"
 )
 
-var _ Validatable = (Struct)(nil)
+var _ ContextValidatable = (Struct)(nil)
 
 // Struct is a map of z-tags to Validatable schemas.
 // Corresponding tags in the struct will be validated against their schemas.
@@ -18,12 +22,23 @@ type Struct map[string]Validatable
 //	=> data is not a struct or a (non-nil) struct pointer
 //	=> a tag is not found in the schema
 //	=> a tag is found in the schema but fails its schema's validation
+//	=> data is a struct pointer that (directly or indirectly) points back to itself
+//
+// Fields are validated in sorted tag order. When validated with a context from WithConcurrency,
+// fields are validated concurrently instead, but errors are still returned in sorted tag order.
 func (s Struct) Validate(data any, tags ...string) Errors {
+	return validate(s, data, tags...)
+}
+
+// ValidateContext validates a struct or struct pointer against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (s Struct) ValidateContext(ctx context.Context, data any, tags ...string) (Errors, error) {
+	return validateContext(ctx, s, data, tags...)
+}
+
+func (s Struct) walk(r *run, data any, tags ...string) Errors {
 	if data == nil {
-		if len(tags) > 0 {
-			return internal.NewValidationErrors("<" + tags[0] + "> failed validation for <struct> (nil interface)")
-		}
-		return internal.NewValidationErrors("failed validation for <struct> (nil interface)")
+		return r.report(typeMismatch("struct", tagOf(tags), data, "nil interface"))
 	}
 
 	// ensure data is a struct or struct pointer
@@ -34,22 +49,24 @@ func (s Struct) Validate(data any, tags ...string) Errors {
 	if kind == reflect.Ptr {
 		if value.IsNil() {
 			// if data is a nil pointer, and struct isn't optional, return an error
+			return r.report(typeMismatch("struct", tagOf(tags), data, "nil pointer"))
+		}
+		var ok bool
+		if r, ok = r.enter(value); !ok {
+			// if data has already been passed through, it's cyclic and would be validated forever
 			if len(tags) > 0 {
-				return internal.NewValidationErrors("<" + tags[0] + "> failed validation for <struct> (nil pointer)")
+				return r.errors(CodeCycle, tags, "<"+tags[0]+"> failed validation for <struct> (cycle detected)")
 			}
-			return internal.NewValidationErrors("failed validation for <struct> (nil pointer)")
+			return r.errors(CodeCycle, nil, "failed validation for <struct> (cycle detected)")
 		}
-		// if data is a pointer, dereference it
-		data = value.Elem().Interface()
-		t = reflect.TypeOf(data)
+		// if data is a pointer, dereference it (keeping its fields addressable)
+		value = value.Elem()
+		t = value.Type()
 		kind = t.Kind()
 	}
 	if kind != reflect.Struct {
 		// if data is not a struct, even after dereferencing, return an error
-		if len(tags) > 0 {
-			return internal.NewValidationErrors("failed validation for <" + tags[0] + ">")
-		}
-		return internal.NewValidationErrors("failed validation for <struct>")
+		return r.report(typeMismatch("struct", tagOf(tags), data))
 	}
 
 	// grab the values from the struct
@@ -61,9 +78,18 @@ func (s Struct) Validate(data any, tags ...string) Errors {
 		if tag == "" || tag == "-" {
 			continue
 		}
-		values[tag] = reflect.ValueOf(data).FieldByName(field.Name).Interface()
+		fieldValue := value.Field(i)
+		if w, ok := s[tag].(writer); ok && w.writes() && fieldValue.CanAddr() && fieldValue.Kind() != reflect.Ptr {
+			// schemas that write back (e.g. Catch) are handed the field's address so the written value sticks
+			values[tag] = fieldValue.Addr().Interface()
+			continue
+		}
+		values[tag] = fieldValue.Interface()
 	}
 
+	// let the schemas of the fields see each other (e.g. for comparing a password against a username)
+	r = r.within(values)
+
 	// due to maps being unordered, sort tags to allow for predictable validation
 	keys := make([]string, 0, len(s))
 	for k := range s {
@@ -71,8 +97,17 @@ func (s Struct) Validate(data any, tags ...string) Errors {
 	}
 	slices.Sort(keys)
 
+	// in concurrent runs, fields are validated up front, but their errors are still appended in sorted tag order
+	var results []Errors
+	if r.options.concurrency > 1 {
+		results = s.walkConcurrently(r, keys, values, tags...)
+	}
+
 	errs := internal.NewValidationErrors()
-	for _, tag := range keys {
+	for i, tag := range keys {
+		if results == nil && r.done(0) {
+			break
+		}
 		schema := s[tag]
 
 		value, exists := values[tag]
@@ -81,27 +116,122 @@ func (s Struct) Validate(data any, tags ...string) Errors {
 		}
 		if !exists {
 			// if there's no matching value, don't bother validating
-			return internal.NewValidationErrors("tag <" + tag + "> not found for <struct>")
+			return r.errors(CodeTagNotFound, []string{tag}, "tag <"+tag+"> not found for <struct>")
 		}
 
 		// recursively validate values, appending any errors to the returned ValidationErrors
-		if err := schema.Validate(value, tag); err != nil {
-			errs.Errors = append(errs.Errors, err.All()...)
+		var err Errors
+		if results != nil {
+			err = results[i]
+		} else {
+			err = r.walk(schema, value, tag)
+		}
+		if err != nil {
+			errs.Append(err.Issues()...)
 		}
 	}
 
-	if len(errs.Errors) > 0 {
+	if errs.Len() > 0 {
 		return errs
 	}
 	return nil
 }
 
+// walkConcurrently validates the values of keys, up to the run's concurrency limit at a time, returning their
+// errors in the order of keys. Returns nil if a key has no value, leaving the error to be reported in order.
+func (s Struct) walkConcurrently(r *run, keys []string, values map[string]any, tags ...string) []Errors {
+	for _, tag := range keys {
+		if _, exists := values[tag]; !exists {
+			return nil
+		}
+	}
+
+	results := make([]Errors, len(keys))
+	limit := make(chan struct{}, r.options.concurrency)
+	var wg sync.WaitGroup
+	for i, tag := range keys {
+		if r.done(0) {
+			break
+		}
+		schema, value := s[tag], values[tag]
+		if len(tags) > 0 {
+			tag = tags[0] + "." + tag
+		}
+
+		limit <- struct{}{}
+		wg.Add(1)
+		go func(i int, schema Validatable, value any, tag string) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			results[i] = r.walk(schema, value, tag)
+		}(i, schema, value, tag)
+	}
+	wg.Wait()
+	return results
+}
+
+// Err returns the errors hit while building the schemas of the struct's fields (and of the structs nested within
+// them), such as a Regex that doesn't compile, joined together. Each is prefixed with the path of its field.
+// Checking Err where a schema is declared (e.g. in an init func or a test) catches broken schemas at startup,
+// rather than on the first Validate. Lazy schemas aren't resolved, so the schemas within them aren't checked.
+//
+//	var userSchema = z.Struct{"name": z.String().Regex(`^[a-z]+$`)}
+//
+//	func init() {
+//		if err := userSchema.Err(); err != nil {
+//			panic(err)
+//		}
+//	}
+func (s Struct) Err() error { return schemaErr(s, "") }
+
+// schemaErr returns the errors hit while building schema, and the schemas nested within it, at path.
+func schemaErr(schema Validatable, path string) error {
+	switch schema := schema.(type) {
+	case Struct:
+		tags := make([]string, 0, len(schema))
+		for tag := range schema {
+			tags = append(tags, tag)
+		}
+		slices.Sort(tags)
+		errs := make([]error, 0, len(tags))
+		for _, tag := range tags {
+			if path != "" {
+				errs = append(errs, schemaErr(schema[tag], path+"."+tag))
+			} else {
+				errs = append(errs, schemaErr(schema[tag], tag))
+			}
+		}
+		return errors.Join(errs...)
+	case OptionalStruct:
+		return schemaErr(Struct(schema), path)
+	case *CatchStruct:
+		return schemaErr(schema.schema, path)
+	case interface{ Err() error }:
+		err := schema.Err()
+		if err != nil && path != "" {
+			err = fmt.Errorf("<%s>: %w", path, err)
+		}
+		return err
+	}
+	return nil
+}
+
 // Optional converts z.Struct to z.OptionalStruct marking it as optional.
 // Calling Validate with nil or a nil string pointer will skip validation.
 func (s Struct) Optional() OptionalStruct {
 	return OptionalStruct(s)
 }
 
+// Catch converts z.Struct to a z.CatchStruct with a fallback value. If validation fails, Validate returns no
+// Errors and, if data is a struct pointer (or a nested struct validated through a struct pointer), the fallback
+// is written into it. The fallback may be a struct or a pointer to one. The swallowed issues are recorded in
+// warnings, if provided.
+func (s Struct) Catch(value any, warnings ...*Warnings) *CatchStruct {
+	return newCatchStruct(s, value, warnings)
+}
+
 // OptionalStruct is a map of z-tags to Validatable schemas. If the data is
 // nil, or a nil pointer, validation will be skipped.
 type OptionalStruct map[string]Validatable
@@ -113,6 +243,16 @@ type OptionalStruct map[string]Validatable
 //	=> a tag is not found in the schema
 //	=> a tag is found in the schema but fails its schema's validation
 func (s OptionalStruct) Validate(data any, tags ...string) Errors {
+	return validate(s, data, tags...)
+}
+
+// ValidateContext validates a struct or a struct pointer (if it's not nil) against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (s OptionalStruct) ValidateContext(ctx context.Context, data any, tags ...string) (Errors, error) {
+	return validateContext(ctx, s, data, tags...)
+}
+
+func (s OptionalStruct) walk(r *run, data any, tags ...string) Errors {
 	if data == nil {
 		return nil
 	}
@@ -127,5 +267,13 @@ func (s OptionalStruct) Validate(data any, tags ...string) Errors {
 		}
 	}
 
-	return Struct(s).Validate(data, tags...)
+	return Struct(s).walk(r, data, tags...)
+}
+
+// Err returns the errors hit while building the schemas of the struct's fields. See Struct.Err.
+func (s OptionalStruct) Err() error { return schemaErr(s, "") }
+
+// Catch converts z.OptionalStruct to a z.CatchStruct with a fallback value. See Struct.Catch.
+func (s OptionalStruct) Catch(value any, warnings ...*Warnings) *CatchStruct {
+	return newCatchStruct(s, value, warnings)
 }
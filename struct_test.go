@@ -0,0 +1,128 @@
+package z
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Rules reading other fields see them as they were before any schema ran, so schemas writing back into their
+// fields don't race with them (run with -race), and concurrent runs agree with sequential ones.
+func TestStructFieldsSnapshot(t *testing.T) {
+	type signup struct {
+		User string `z:"user"`
+		Pass string `z:"pass"`
+	}
+	type payment struct {
+		Amount   string `z:"amount"`
+		Currency string `z:"currency"`
+	}
+
+	for _, concurrency := range []int{1, 2} {
+		ctx := WithConcurrency(context.Background(), concurrency)
+		for i := 0; i < 50; i++ {
+			users := Struct{
+				"user": String().Normalize(NFKC).WriteBack(),
+				"pass": String().Password(PasswordPolicy{NotContaining: []string{"user"}}),
+			}
+			user := signup{User: "ｂｏｂ", Pass: "xxbobxx"}
+			errs, err := users.ValidateContext(ctx, &user)
+			if errs != nil || err != nil {
+				t.Fatalf("concurrency %d: got %v, %v; want no errors", concurrency, errs, err)
+			}
+			if user.User != "bob" {
+				t.Fatalf("concurrency %d: user = %q, want it written back as %q", concurrency, user.User, "bob")
+			}
+
+			payments := Struct{
+				"amount":   Money("currency"),
+				"currency": String().Currency().Catch("JPY"),
+			}
+			pay := payment{Amount: "10.5", Currency: "usd"}
+			errs, err = payments.ValidateContext(ctx, &pay)
+			if errs != nil || err != nil {
+				t.Fatalf("concurrency %d: got %v, %v; want no errors", concurrency, errs, err)
+			}
+			if pay.Currency != "JPY" {
+				t.Fatalf("concurrency %d: currency = %q, want the fallback %q", concurrency, pay.Currency, "JPY")
+			}
+		}
+	}
+}
+
+// A schema validates any number of values at a time, each against its own state, without waiting for the others'
+// rules to finish: every call below is within the CustomCtx rule at once (run with -race).
+func TestSchemaConcurrentUse(t *testing.T) {
+	const calls = 8
+	var arrived sync.WaitGroup
+	arrived.Add(calls)
+	all := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(all)
+	}()
+	schema := String().Min(2).CustomCtx(func(ctx context.Context, s string) error {
+		arrived.Done()
+		select {
+		case <-all:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tag, value := fmt.Sprint("field", i), "ok"
+			if i%2 == 1 {
+				value = "x"
+			}
+			errs, err := schema.ValidateContext(ctx, value, tag)
+			if err != nil {
+				t.Errorf("%s: ValidateContext = %v, want every call within the rule at once", tag, err)
+				return
+			}
+			switch want := "<" + tag + "> failed <string> validation for <Min(2)>"; {
+			case value == "ok" && errs != nil:
+				t.Errorf("%s: Validate(%q) = %q, want no errors", tag, value, errs.All())
+			case value == "x" && (errs == nil || errs.One() != want):
+				t.Errorf("%s: Validate(%q) = %v, want %q", tag, value, errs, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Struct.Err reports the schema errors of every field, prefixed with its path, so broken schemas are caught at startup.
+func TestStructErr(t *testing.T) {
+	schema := Struct{
+		"name":    String().Regexp(nil),
+		"code":    String().Regex("("),
+		"scripts": String().Scripts([]string{"Latin", "Klingon"}),
+		"address": Struct{"zip": String().Regex(`^\d{5}$`)},
+	}
+	err := schema.Err()
+	if !errors.Is(err, ErrInvalidSchema) {
+		t.Fatalf("Err() = %v, want it to wrap ErrInvalidSchema", err)
+	}
+	for _, path := range []string{"name", "code", "scripts"} {
+		if !strings.Contains(err.Error(), "<"+path+">: ") {
+			t.Errorf("Err() = %q, want an error for %s", err, path)
+		}
+	}
+	if strings.Contains(err.Error(), "address") {
+		t.Errorf("Err() = %q, want no error for address", err)
+	}
+	if errs := String().Regexp(nil).Validate("x"); errs == nil || errs.Issues()[0].Code != CodeInvalidSchema {
+		t.Errorf(`Regexp(nil).Validate("x") = %v, want an invalid schema issue`, errs)
+	}
+}
@@ -1,16 +1,18 @@
 package z
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/MarcusSanchez/go-z/internal"
 )
 
 var (
-	_ Validatable = (*ValidatableUint[uint])(nil)
-	_ Validatable = (*ValidatableUint[uint8])(nil)
-	_ Validatable = (*ValidatableUint[uint16])(nil)
-	_ Validatable = (*ValidatableUint[uint32])(nil)
-	_ Validatable = (*ValidatableUint[uint64])(nil)
+	_ ContextValidatable = (*ValidatableUint[uint])(nil)
+	_ ContextValidatable = (*ValidatableUint[uint8])(nil)
+	_ ContextValidatable = (*ValidatableUint[uint16])(nil)
+	_ ContextValidatable = (*ValidatableUint[uint32])(nil)
+	_ ContextValidatable = (*ValidatableUint[uint64])(nil)
 )
 
 type uints interface {
@@ -19,10 +21,14 @@ type uints interface {
 
 // ValidatableUint is a uint, uint8, uint16, uint32, or uint64 that can be validated.
 type ValidatableUint[T uints] struct {
-	tag      *string
-	value    T
-	rules    []rule
+	tag   *string
+	value T
+	// rules build the rules of the schema for the copy of it made to validate a value (see walk).
+	rules    []func(v *ValidatableUint[T]) rule
 	optional bool
+	failFast bool
+	run      *run
+	fallback *fallback[T]
 }
 
 // Validate validates a uint, uint8, uint16, uint32, or uint64 against its schema.
@@ -31,35 +37,48 @@ type ValidatableUint[T uints] struct {
 //	=> data is not a uint, uint8, uint16, uint32, or uint64 or doesn't match the correct generic type
 //	=> data fails any of the schema's rules
 func (v *ValidatableUint[T]) Validate(data any, tag ...string) Errors {
+	return validate(v, data, tag...)
+}
+
+// ValidateContext validates a uint, uint8, uint16, uint32, or uint64 against its schema, passing ctx to context-aware rules.
+// Errors that aren't validation failures (e.g. ctx being done) are returned separately.
+func (v *ValidatableUint[T]) ValidateContext(ctx context.Context, data any, tag ...string) (Errors, error) {
+	return validateContext(ctx, v, data, tag...)
+}
+
+// walk validates data against a copy of the schema, which holds the value being validated, so the schema can
+// validate any number of values at a time.
+func (v *ValidatableUint[T]) walk(r *run, data any, tag ...string) Errors {
+	c := *v
+	return c.walkCopy(r, data, tag...)
+}
+
+// walkCopy is walk for the copy of the schema made to validate data.
+func (v *ValidatableUint[T]) walkCopy(r *run, data any, tag ...string) Errors {
 	if len(tag) > 0 {
 		v.tag = &tag[0]
 	}
-	if v.optional {
-		if data == nil {
+	if v.optional && data == nil {
+		return nil
+	}
+	var target *T
+	if value, ok := data.(*T); ok && (v.optional || v.fallback != nil) {
+		if value == nil && v.optional {
 			return nil
 		}
-		if value, ok := data.(*T); ok {
-			if value == nil {
-				return nil
-			}
+		if value != nil {
+			target = value
 			data = *value
 		}
 	}
 	var ok bool
 	if v.value, ok = data.(T); !ok {
-		if v.tag == nil {
-			return internal.NewValidationErrors(fmt.Sprintf("failed validation for <%T>", v.value))
-		}
-		return internal.NewValidationErrors(fmt.Sprintf("<%s> failed validation for <%T>", *v.tag, v.value))
-	}
-	vErrors := make([]string, 0, len(v.rules))
-	for _, rule := range v.rules {
-		if err := rule(); err != "" {
-			vErrors = append(vErrors, err)
-		}
+		return r.report(v.fallback.apply(target, typeMismatch(fmt.Sprintf("%T", v.value), v.tag, data)))
 	}
+	v.run = r
+	vErrors := v.run.check(bind(v, v.rules), v.failFast, v.tag)
 	if len(vErrors) > 0 {
-		return internal.NewValidationErrors(vErrors...)
+		return r.report(v.fallback.apply(target, internal.NewValidationIssues(vErrors...)))
 	}
 	return nil
 }
@@ -70,174 +89,363 @@ func (v *ValidatableUint[T]) Optional() *ValidatableUint[T] {
 	return v
 }
 
+// FailFast stops validating the uint at its first failing rule, rather than running every rule.
+func (v *ValidatableUint[T]) FailFast() *ValidatableUint[T] {
+	v.failFast = true
+	return v
+}
+
+// Catch marks the uint with a fallback value. If validation fails, Validate returns no Errors and, if data is a
+// uint pointer (or a struct field validated through a struct pointer), the fallback is written into it.
+// The swallowed issues are recorded in warnings, if provided.
+func (v *ValidatableUint[T]) Catch(value T, warnings ...*Warnings) *ValidatableUint[T] {
+	v.fallback = newFallback(value, warnings)
+	return v
+}
+
+func (v *ValidatableUint[T]) writes() bool { return v.fallback != nil }
+
 // Lt appends a rule validating that data is less than the provided max. (data < max)
 func (v *ValidatableUint[T]) Lt(max T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value < max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Lt(%d)>", *v.tag, v.value, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Lt(%d)>", v.value, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeLt, check: func() string {
+			switch {
+			case v.value < max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Lt(%d)>", *v.tag, v.value, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Lt(%d)>", v.value, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Gt appends a rule validating that data is greater than the provided min. (data > min)
 func (v *ValidatableUint[T]) Gt(min T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value > min:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Gt(%d)>", *v.tag, v.value, min)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Gt(%d)>", v.value, min)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeGt, check: func() string {
+			switch {
+			case v.value > min:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Gt(%d)>", *v.tag, v.value, min)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Gt(%d)>", v.value, min)
+			}
+		}}
 	})
 	return v
 }
 
 // Lte appends a rule validating that data is less than or equal to the provided max. (data <= max)
 func (v *ValidatableUint[T]) Lte(max T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value <= max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Lte(%d)>", *v.tag, v.value, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Lte(%d)>", v.value, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeLte, check: func() string {
+			switch {
+			case v.value <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Lte(%d)>", *v.tag, v.value, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Lte(%d)>", v.value, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Gte appends a rule validating that data is greater than or equal to the provided min. (data >= min)
 func (v *ValidatableUint[T]) Gte(min T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value >= min:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Gte(%d)>", *v.tag, v.value, min)
-		default:
-			return fmt.Sprintf("<%T> failed validation for <Gte(%d)>", v.value, min)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeGte, check: func() string {
+			switch {
+			case v.value >= min:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Gte(%d)>", *v.tag, v.value, min)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Gte(%d)>", v.value, min)
+			}
+		}}
 	})
 	return v
 }
 
 // Range appends a rule validating that data is within the provided range. (min <= data <= max)
 func (v *ValidatableUint[T]) Range(min, max T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case min <= v.value && v.value <= max:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Range(%d, %d)>", *v.tag, v.value, min, max)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Range(%d, %d)>", v.value, min, max)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeRange, check: func() string {
+			switch {
+			case min <= v.value && v.value <= max:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Range(%d, %d)>", *v.tag, v.value, min, max)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Range(%d, %d)>", v.value, min, max)
+			}
+		}}
 	})
 	return v
 }
 
 // Eq appends a rule validating that data is equal to the provided value. (data == to)
 func (v *ValidatableUint[T]) Eq(to T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value == to:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Eq(%d)>", *v.tag, v.value, to)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Eq(%d)>", v.value, to)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeEq, check: func() string {
+			switch {
+			case v.value == to:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Eq(%d)>", *v.tag, v.value, to)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Eq(%d)>", v.value, to)
+			}
+		}}
 	})
 	return v
 }
 
 // NotEq appends a rule validating that data is not equal to the provided value. (data != to)
 func (v *ValidatableUint[T]) NotEq(to T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != to:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NotEq(%d)>", *v.tag, v.value, to)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NotEq(%d)>", v.value, to)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeNotEq, check: func() string {
+			switch {
+			case v.value != to:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NotEq(%d)>", *v.tag, v.value, to)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NotEq(%d)>", v.value, to)
+			}
+		}}
 	})
 	return v
 }
 
 // NonZero appends a rule validating that data is not equal to zero. (data != 0)
 func (v *ValidatableUint[T]) NonZero(msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case v.value != 0:
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <NonZero>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <NonZero>", v.value)
-		}
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeNonZero, check: func() string {
+			switch {
+			case v.value != 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <NonZero>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <NonZero>", v.value)
+			}
+		}}
 	})
 	return v
 }
 
 // In appends a rule validating that data is in the provided slice of values.
 func (v *ValidatableUint[T]) In(values []T, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		for _, value := range values {
-			if v.value == value {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeIn, check: func() string {
+			for _, value := range values {
+				if v.value == value {
+					return ""
+				}
+			}
+			if len(msg) > 0 {
+				return msg[0]
+			}
+			if v.tag != nil {
+				return fmt.Sprintf("<%s> failed <%T> validation for <In(%v)>", *v.tag, v.value, values)
+			}
+			return fmt.Sprintf("failed <%T> validation for <In(%v)>", v.value, values)
+		}}
+	})
+	return v
+}
+
+// MultipleOf appends a rule validating that data is a multiple of step. (data % step == 0)
+// A step of zero fails every value. Issues hold the step in their Params under "step", which is stable, for use
+// as JSON Schema's multipleOf.
+func (v *ValidatableUint[T]) MultipleOf(step T, msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeMultipleOf, check: func() string {
+			switch {
+			case step != 0 && v.value%step == 0:
 				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <MultipleOf(%d)>", *v.tag, v.value, step)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <MultipleOf(%d)>", v.value, step)
 			}
-		}
-		if len(msg) > 0 {
-			return msg[0]
-		}
-		if v.tag != nil {
-			return fmt.Sprintf("<%s> failed <%T> validation for <In(%s)>", *v.tag, v.value, values)
-		}
-		return fmt.Sprintf("failed <%T> validation for <In(%s)>", v.value, values)
+		}, params: func() map[string]any {
+			return map[string]any{"step": step}
+		}}
+	})
+	return v
+}
+
+// Step appends a rule validating that data is offset plus a multiple of step (e.g. 1, 6, 11, ... for a step of 5
+// and an offset of 1). ((data - offset) % step == 0) A step of zero fails every value. Issues hold the step and
+// offset in their Params under "step" and "offset", which are stable; with an offset of zero, the step can be
+// used as JSON Schema's multipleOf, which has no offset.
+func (v *ValidatableUint[T]) Step(step, offset T, msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeStep, check: func() string {
+			switch {
+			case step != 0 && v.value%step == offset%step:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Step(%d, %d)>", *v.tag, v.value, step, offset)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Step(%d, %d)>", v.value, step, offset)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"step": step, "offset": offset}
+		}}
+	})
+	return v
+}
+
+// Even appends a rule validating that data is even. (data % 2 == 0)
+func (v *ValidatableUint[T]) Even(msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeEven, check: func() string {
+			switch {
+			case v.value%2 == 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Even>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Even>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// Odd appends a rule validating that data is odd. (data % 2 != 0)
+func (v *ValidatableUint[T]) Odd(msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeOdd, check: func() string {
+			switch {
+			case v.value%2 != 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Odd>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Odd>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// PowerOfTwo appends a rule validating that data is a power of two (1, 2, 4, 8, ...).
+func (v *ValidatableUint[T]) PowerOfTwo(msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodePowerOfTwo, check: func() string {
+			switch {
+			case v.value > 0 && v.value&(v.value-1) == 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <PowerOfTwo>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <PowerOfTwo>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// Flags appends a rule validating that data, as a bitfield, only has bits of mask set. (data &^ mask == 0)
+// Useful for bitfields of permissions or options, to reject bits no flag is defined for.
+func (v *ValidatableUint[T]) Flags(mask T, msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeFlags, check: func() string {
+			switch {
+			case v.value&^mask == 0:
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Flags(%#x)>", *v.tag, v.value, mask)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Flags(%#x)>", v.value, mask)
+			}
+		}, params: func() map[string]any {
+			return map[string]any{"mask": mask, "unknown": v.value &^ mask}
+		}}
 	})
 	return v
 }
 
 // Custom appends a custom rule to the schema. Validates if the provided function returns true when passed data.
-func (v *ValidatableUint[T]) Custom(rule func(T) bool, msg ...string) *ValidatableUint[T] {
-	v.rules = append(v.rules, func() string {
-		switch {
-		case rule(v.value):
-			return ""
-		case len(msg) > 0:
-			return msg[0]
-		case v.tag != nil:
-			return fmt.Sprintf("<%s> failed <%T> validation for <Custom>", *v.tag, v.value)
-		default:
-			return fmt.Sprintf("failed <%T> validation for <Custom>", v.value)
-		}
+func (v *ValidatableUint[T]) Custom(fn func(T) bool, msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		return rule{code: CodeCustom, check: func() string {
+			switch {
+			case fn(v.value):
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <Custom>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <Custom>", v.value)
+			}
+		}}
+	})
+	return v
+}
+
+// CustomCtx appends a context-aware custom rule to the schema, for rules that need cancellation or deadlines
+// (e.g. querying a database). Validates if the provided function returns nil when passed the run's context and data.
+// The function fails validation by returning ErrInvalid (or an error wrapping it); any other error stops validation
+// and is returned separately by ValidateContext.
+func (v *ValidatableUint[T]) CustomCtx(fn func(context.Context, T) error, msg ...string) *ValidatableUint[T] {
+	v.rules = append(v.rules, func(v *ValidatableUint[T]) rule {
+		var err error
+		return rule{code: CodeCustomCtx, check: func() string {
+			err = fn(v.run.ctx, v.value)
+			switch {
+			case err == nil:
+				return ""
+			case !errors.Is(err, ErrInvalid):
+				v.run.fail(err)
+				return ""
+			case len(msg) > 0:
+				return msg[0]
+			case v.tag != nil:
+				return fmt.Sprintf("<%s> failed <%T> validation for <CustomCtx>", *v.tag, v.value)
+			default:
+				return fmt.Sprintf("failed <%T> validation for <CustomCtx>", v.value)
+			}
+		}, cause: func() error { return err }}
 	})
 	return v
 }
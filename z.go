@@ -1,7 +1,12 @@
 // Package z, inspired by zod, is a library for validating structs and other primitives.
 package z
 
-import "github.com/MarcusSanchez/go-z/internal"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MarcusSanchez/go-z/internal"
+)
 
 // Validatable interface is implemented by all z-primitives.
 type Validatable interface {
@@ -9,18 +14,113 @@ type Validatable interface {
 	Validate(data any, tags ...string) Errors
 }
 
+// ContextValidatable interface is implemented by all z-primitives. Schemas that implement it are passed the
+// context of the run when nested within another schema validated with ValidateContext.
+type ContextValidatable interface {
+	Validatable
+	// ValidateContext validates a primitive against its schema, passing ctx to context-aware rules.
+	// Returns Errors if any rules fail, and an error if validation couldn't be completed (e.g. ctx is done).
+	ValidateContext(ctx context.Context, data any, tags ...string) (Errors, error)
+}
+
+// ErrInvalid is returned (or wrapped) by context-aware rules to fail validation. Any other error
+// returned by a context-aware rule is treated as a failure to validate, rather than invalid data.
+var ErrInvalid = errors.New("z: invalid")
+
+// ErrInvalidSchema is wrapped by the errors hit while building a schema, such as a Regex that doesn't compile.
+var ErrInvalidSchema = errors.New("z: invalid schema")
+
+// ErrTypeMismatch is the Cause of issues for data that isn't of the type a schema validates (e.g. a string given
+// to z.Int()).
+var ErrTypeMismatch = errors.New("z: type mismatch")
+
+// ErrRequired is the Cause of issues for data that is missing (nil, or a nil pointer) but not optional.
+var ErrRequired = errors.New("z: required")
+
 // Errors interface is z's custom error type. It is returned by all of z-primitives' Validate methods.
+//
+// Errors unwrap to their issues (as *Issue), so errors.As gives access to the first issue, and errors.Is matches
+// the Cause of any issue:
+//
+//	if errors.Is(errs, z.ErrRequired) { ... }
+//
+//	var issue *z.Issue
+//	if errors.As(errs, &issue) { ... }
+//
+// None of the methods of Errors panic when there are no issues.
 type Errors interface {
 	// One returns the first failed validation message. If schema is a struct, it will return the
 	// first failed validation message of the tag with alphabetical priority (a->z).
 	One() string
 	// All returns all failed validation messages in a slice.
 	All() []string
+	// Issues returns all failed validations in a slice, in the same order as All.
+	Issues() []Issue
+	// Truncated reports whether validation stopped early, because of WithFailFast or WithMaxErrors, dropping
+	// errors or leaving rules or fields unvalidated, meaning the data may have more errors than were returned.
+	Truncated() bool
+	// Flatten returns all failed validation messages keyed by the Path of their issue (e.g. "address.zip"),
+	// with the messages for the top-level value under "".
+	Flatten() map[string][]string
+	// Format returns all failed validation messages as a tree mirroring the shape of the data, for showing
+	// messages next to the fields they're about.
+	Format() *ErrorTree
 	// Error is for compatibility with the error interface.
 	// It returns a string representation of All() joined by pipes.
 	Error() string
 }
 
-type rule func() string
+// ErrorTree is a tree of failed validation messages, mirroring the shape of the data. Its JSON form has the
+// messages for each value under "_errors", alongside the trees of the values nested within it:
+//
+//	{"_errors": [], "address": {"_errors": [], "zip": {"_errors": ["<address.zip> failed <string> validation for <Min(5)>"]}}}
+type ErrorTree = internal.Tree
+
+// Issue is a single failed validation, carrying a stable Code alongside its Path and Message. An *Issue is an error
+// that unwraps to its Cause.
+type Issue = internal.Issue
+
+// rule is a validation appended to a schema. check returns a message if the rule fails, or an empty string.
+// If the rule fails, params (if set) returns the Params of its issue, and cause (if set) its Cause.
+type rule struct {
+	code   string
+	check  func() string
+	params func() map[string]any
+	cause  func() error
+	// issues, if set, replaces check for rules that validate data against nested schemas, returning their issues.
+	issues func() []Issue
+}
+
+// typeMismatch returns the Errors for data that isn't of the type a schema validates, named kind in the message
+// (e.g. "<age> failed validation for <int>"), with detail (if given) appended in parentheses. Every schema reports
+// mismatched data through it, so the issues have the same shape whatever the schema. Missing data (nil, or a nil
+// pointer) is reported as required, rather than as the wrong type.
+func typeMismatch(kind string, tag *string, data any, detail ...string) Errors {
+	message := "failed validation for <" + kind + ">"
+	if len(detail) > 0 {
+		message += " (" + detail[0] + ")"
+	}
+	received := "nil"
+	if data != nil {
+		received = fmt.Sprintf("%T", data)
+	}
+	issue := Issue{Code: CodeType, Message: message, Params: map[string]any{"expected": kind, "received": received}, Cause: ErrTypeMismatch}
+	if isNil(data) {
+		issue.Code, issue.Cause = CodeRequired, ErrRequired
+	}
+	if tag != nil {
+		issue.Path = *tag
+		issue.Message = "<" + *tag + "> " + message
+	}
+	return internal.NewValidationIssues(issue)
+}
+
+// tagOf returns the first of tags, or nil if there are none.
+func tagOf(tags []string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return &tags[0]
+}
 
 var _ Errors = (*internal.ValidationErrors)(nil)
@@ -0,0 +1,2203 @@
+package z
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// payment is validated by the Money cases, as an amount's currency is given by another field.
+type payment struct {
+	Amount   string `z:"amount"`
+	Currency string `z:"currency"`
+}
+
+// node is a linked list, for the cases validating data that points back to itself or is nested too deeply.
+type node struct {
+	Next *node `z:"next"`
+}
+
+// nodeSchema returns a schema for a linked list of nodes, nested at most depth deep (if depth is positive).
+func nodeSchema(depth int) Struct {
+	var schema Struct
+	next := Lazy(func() Validatable { return schema.Optional() })
+	if depth > 0 {
+		next.MaxDepth(depth)
+	}
+	schema = Struct{"next": next}
+	return schema
+}
+
+// loop returns a node that points back to itself.
+func loop() *node {
+	n := &node{}
+	n.Next = n
+	return n
+}
+
+var breached, _ = LoadPasswordList(strings.NewReader("password\n"))
+
+// TestConformance checks that every schema reports mismatched data, and every rule its failure, as a single issue
+// of the same shape. Each case is validated untagged, tagged, and (for rules that take one) with a custom message.
+func TestConformance(t *testing.T) {
+	tests := []struct {
+		name string
+		// schema returns the schema, passing msg to the rule under test (if it takes one).
+		schema func(msg ...string) Validatable
+		data   any
+		// the issue data fails with, untagged
+		code    string
+		path    string
+		message string
+		params  map[string]any
+		cause   error
+		// fixed is set for cases whose message can't be customized, and volatile for those whose Params hold the
+		// time of validation, which aren't compared.
+		fixed    bool
+		volatile bool
+	}{
+		{
+			name:    "String",
+			schema:  func(...string) Validatable { return String() },
+			data:    1,
+			code:    CodeType,
+			message: "failed validation for <string>",
+			params:  map[string]any{"expected": "string", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "String/nil",
+			schema:  func(...string) Validatable { return String() },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <string>",
+			params:  map[string]any{"expected": "string", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "String/nil pointer",
+			schema:  func(...string) Validatable { return String() },
+			data:    (*string)(nil),
+			code:    CodeRequired,
+			message: "failed validation for <string>",
+			params:  map[string]any{"expected": "string", "received": "*string"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Int",
+			schema:  func(...string) Validatable { return Int() },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int>",
+			params:  map[string]any{"expected": "int", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Int8",
+			schema:  func(...string) Validatable { return Int8() },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int8>",
+			params:  map[string]any{"expected": "int8", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Int16",
+			schema:  func(...string) Validatable { return Int16() },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int16>",
+			params:  map[string]any{"expected": "int16", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Int32",
+			schema:  func(...string) Validatable { return Int32() },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int32>",
+			params:  map[string]any{"expected": "int32", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Int64",
+			schema:  func(...string) Validatable { return Int64() },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int64>",
+			params:  map[string]any{"expected": "int64", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Int/nil",
+			schema:  func(...string) Validatable { return Int() },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <int>",
+			params:  map[string]any{"expected": "int", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Uint",
+			schema:  func(...string) Validatable { return Uint() },
+			data:    -1,
+			code:    CodeType,
+			message: "failed validation for <uint>",
+			params:  map[string]any{"expected": "uint", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Uint8",
+			schema:  func(...string) Validatable { return Uint8() },
+			data:    -1,
+			code:    CodeType,
+			message: "failed validation for <uint8>",
+			params:  map[string]any{"expected": "uint8", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Uint16",
+			schema:  func(...string) Validatable { return Uint16() },
+			data:    -1,
+			code:    CodeType,
+			message: "failed validation for <uint16>",
+			params:  map[string]any{"expected": "uint16", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Uint32",
+			schema:  func(...string) Validatable { return Uint32() },
+			data:    -1,
+			code:    CodeType,
+			message: "failed validation for <uint32>",
+			params:  map[string]any{"expected": "uint32", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Uint64",
+			schema:  func(...string) Validatable { return Uint64() },
+			data:    -1,
+			code:    CodeType,
+			message: "failed validation for <uint64>",
+			params:  map[string]any{"expected": "uint64", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Float32",
+			schema:  func(...string) Validatable { return Float32() },
+			data:    float64(1),
+			code:    CodeType,
+			message: "failed validation for <float32>",
+			params:  map[string]any{"expected": "float32", "received": "float64"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Float64",
+			schema:  func(...string) Validatable { return Float64() },
+			data:    "1.5",
+			code:    CodeType,
+			message: "failed validation for <float64>",
+			params:  map[string]any{"expected": "float64", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Float64/nil",
+			schema:  func(...string) Validatable { return Float64() },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <float64>",
+			params:  map[string]any{"expected": "float64", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Bool",
+			schema:  func(...string) Validatable { return Bool() },
+			data:    "x",
+			code:    CodeType,
+			message: "failed validation for <bool>",
+			params:  map[string]any{"expected": "bool", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Bool/nil",
+			schema:  func(...string) Validatable { return Bool() },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <bool>",
+			params:  map[string]any{"expected": "bool", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "BigInt",
+			schema:  func(...string) Validatable { return BigInt() },
+			data:    1,
+			code:    CodeType,
+			message: "failed validation for <big.Int>",
+			params:  map[string]any{"expected": "big.Int", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "BigInt/fraction",
+			schema:  func(...string) Validatable { return BigInt() },
+			data:    json.Number("1.5"),
+			code:    CodeType,
+			message: "failed validation for <big.Int>",
+			params:  map[string]any{"expected": "big.Int", "received": "json.Number"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "BigInt/nil pointer",
+			schema:  func(...string) Validatable { return BigInt() },
+			data:    (*big.Int)(nil),
+			code:    CodeRequired,
+			message: "failed validation for <big.Int>",
+			params:  map[string]any{"expected": "big.Int", "received": "*big.Int"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "BigRat",
+			schema:  func(...string) Validatable { return BigRat() },
+			data:    1.5,
+			code:    CodeType,
+			message: "failed validation for <big.Rat>",
+			params:  map[string]any{"expected": "big.Rat", "received": "float64"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Decimal",
+			schema:  func(...string) Validatable { return Decimal() },
+			data:    1.5,
+			code:    CodeType,
+			message: "failed validation for <decimal>",
+			params:  map[string]any{"expected": "decimal", "received": "float64"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Decimal/not a decimal",
+			schema:  func(...string) Validatable { return Decimal() },
+			data:    "1.5.0",
+			code:    CodeType,
+			message: "failed validation for <decimal>",
+			params:  map[string]any{"expected": "decimal", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Money",
+			schema:  func(...string) Validatable { return Money("currency") },
+			data:    1.5,
+			code:    CodeType,
+			message: "failed validation for <money>",
+			params:  map[string]any{"expected": "money", "received": "float64"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    1,
+			code:    CodeType,
+			message: "failed validation for <GeoJSON>",
+			params:  map[string]any{"expected": "GeoJSON", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON/nil",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <GeoJSON>",
+			params:  map[string]any{"expected": "GeoJSON", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Struct",
+			schema:  func(...string) Validatable { return Struct{} },
+			data:    1,
+			code:    CodeType,
+			message: "failed validation for <struct>",
+			params:  map[string]any{"expected": "struct", "received": "int"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "Struct/nil",
+			schema:  func(...string) Validatable { return Struct{} },
+			data:    nil,
+			code:    CodeRequired,
+			message: "failed validation for <struct> (nil interface)",
+			params:  map[string]any{"expected": "struct", "received": "nil"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Struct/nil pointer",
+			schema:  func(...string) Validatable { return Struct{} },
+			data:    (*struct{})(nil),
+			code:    CodeRequired,
+			message: "failed validation for <struct> (nil pointer)",
+			params:  map[string]any{"expected": "struct", "received": "*struct {}"},
+			cause:   ErrRequired,
+			fixed:   true,
+		},
+		{
+			name:    "Lazy",
+			schema:  func(...string) Validatable { return Lazy(func() Validatable { return Int() }) },
+			data:    "1",
+			code:    CodeType,
+			message: "failed validation for <int>",
+			params:  map[string]any{"expected": "int", "received": "string"},
+			cause:   ErrTypeMismatch,
+			fixed:   true,
+		},
+		{
+			name:    "String.Min",
+			schema:  func(msg ...string) Validatable { return String().Min(5, msg...) },
+			data:    "abc",
+			code:    CodeMin,
+			message: "failed <string> validation for <Min(5)>",
+		},
+		{
+			name:    "String.Max",
+			schema:  func(msg ...string) Validatable { return String().Max(2, msg...) },
+			data:    "abc",
+			code:    CodeMax,
+			message: "failed <string> validation for <Max(2)>",
+		},
+		{
+			name:    "String.Min/runes",
+			schema:  func(msg ...string) Validatable { return String().CountBy(Runes).Min(3, msg...) },
+			data:    "hé",
+			code:    CodeMin,
+			message: "failed <string> validation for <Min(3)>",
+		},
+		{
+			name:    "String.Eq",
+			schema:  func(msg ...string) Validatable { return String().Eq("a", msg...) },
+			data:    "b",
+			code:    CodeEq,
+			message: "failed <string> validation for <Eq(a)>",
+		},
+		{
+			name:    "String.NotEq",
+			schema:  func(msg ...string) Validatable { return String().NotEq("a", msg...) },
+			data:    "a",
+			code:    CodeNotEq,
+			message: "failed <string> validation for <NotEq(a)>",
+		},
+		{
+			name:    "String.NotEmpty",
+			schema:  func(msg ...string) Validatable { return String().NotEmpty(msg...) },
+			data:    "",
+			code:    CodeNotEmpty,
+			message: "failed <string> validation for <NotEmpty>",
+		},
+		{
+			name:    "String.In",
+			schema:  func(msg ...string) Validatable { return String().In([]string{"a", "b"}, msg...) },
+			data:    "c",
+			code:    CodeIn,
+			message: "failed <string> validation for <In([a b])>",
+		},
+		{
+			name:    "String.Regex",
+			schema:  func(msg ...string) Validatable { return String().Regex(`^a+$`, msg...) },
+			data:    "b",
+			code:    CodeRegex,
+			message: "failed <string> validation for <Regex(^a+$)>",
+		},
+		{
+			name:    "String.Regexp",
+			schema:  func(msg ...string) Validatable { return String().Regexp(regexp.MustCompile(`^a+$`), msg...) },
+			data:    "b",
+			code:    CodeRegex,
+			message: "failed <string> validation for <Regex(^a+$)>",
+		},
+		{
+			name:    "String.Custom",
+			schema:  func(msg ...string) Validatable { return String().Custom(func(string) bool { return false }, msg...) },
+			data:    "a",
+			code:    CodeCustom,
+			message: "failed <string> validation for <Custom>",
+		},
+		{
+			name: "String.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return String().CustomCtx(func(context.Context, string) error { return ErrInvalid }, msg...)
+			},
+			data:    "a",
+			code:    CodeCustomCtx,
+			message: "failed <string> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "String.Email",
+			schema:  func(msg ...string) Validatable { return String().Email(msg...) },
+			data:    "bob",
+			code:    CodeEmail,
+			message: "failed <string> validation for <Email>",
+		},
+		{
+			name:    "String.EmailWith/RequireTLD",
+			schema:  func(msg ...string) Validatable { return String().EmailWith(EmailPolicy{RequireTLD: true}, msg...) },
+			data:    "bob@localhost",
+			code:    CodeEmailTLD,
+			message: "failed <string> validation for <Email(RequireTLD)>",
+		},
+		{
+			name: "String.EmailWith/AllowDomains",
+			schema: func(msg ...string) Validatable {
+				return String().EmailWith(EmailPolicy{AllowDomains: []string{"example.com"}}, msg...)
+			},
+			data:    "bob@other.com",
+			code:    CodeEmailDomain,
+			message: "failed <string> validation for <Email(AllowDomains)>",
+		},
+		{
+			name: "String.EmailWith/AllowDomains suggestion",
+			schema: func(msg ...string) Validatable {
+				return String().EmailWith(EmailPolicy{AllowDomains: []string{"gmail.com"}, SuggestDomains: CommonEmailDomains}, msg...)
+			},
+			data:    "bob@gmial.com",
+			code:    CodeEmailDomain,
+			message: "failed <string> validation for <Email(AllowDomains)> (did you mean \"bob@gmail.com\"?)",
+			params:  map[string]any{"suggestion": "bob@gmail.com"},
+		},
+		{
+			name: "String.EmailWith/DenyDomains",
+			schema: func(msg ...string) Validatable {
+				return String().EmailWith(EmailPolicy{DenyDomains: []string{"*.example.com"}}, msg...)
+			},
+			data:    "bob@mail.example.com",
+			code:    CodeEmailDomain,
+			message: "failed <string> validation for <Email(DenyDomains)>",
+		},
+		{
+			name: "String.EmailWith/DisposableDomains",
+			schema: func(msg ...string) Validatable {
+				return String().EmailWith(EmailPolicy{DisposableDomains: []string{"mailinator.com"}}, msg...)
+			},
+			data:    "bob@mailinator.com",
+			code:    CodeEmailDisposable,
+			message: "failed <string> validation for <Email(DisposableDomains)>",
+		},
+		{
+			name:    "String.EmailWith/LimitLengths",
+			schema:  func(msg ...string) Validatable { return String().EmailWith(EmailPolicy{LimitLengths: true}, msg...) },
+			data:    strings.Repeat("a", 65) + "@example.com",
+			code:    CodeEmailLength,
+			message: "failed <string> validation for <Email(LimitLengths)>",
+		},
+		{
+			name: "String.EmailWith/PlusAddressing",
+			schema: func(msg ...string) Validatable {
+				return String().EmailWith(EmailPolicy{PlusAddressing: PlusReject}, msg...)
+			},
+			data:    "bob+news@example.com",
+			code:    CodeEmailPlus,
+			message: "failed <string> validation for <Email(PlusAddressing)>",
+		},
+		{
+			name:    "String.Luhn",
+			schema:  func(msg ...string) Validatable { return String().Luhn(msg...) },
+			data:    "79927398710",
+			code:    CodeLuhn,
+			message: "failed <string> validation for <Luhn>",
+		},
+		{
+			name:    "String.CreditCard",
+			schema:  func(msg ...string) Validatable { return String().CreditCard(nil, msg...) },
+			data:    "4111111111111112",
+			code:    CodeCreditCard,
+			message: "failed <string> validation for <CreditCard>",
+		},
+		{
+			name:    "String.CreditCard/brands",
+			schema:  func(msg ...string) Validatable { return String().CreditCard([]CardBrand{CardAmex}, msg...) },
+			data:    "4111111111111111",
+			code:    CodeCreditCard,
+			message: "failed <string> validation for <CreditCard([amex])>",
+		},
+		{
+			name:    "String.IBAN",
+			schema:  func(msg ...string) Validatable { return String().IBAN(msg...) },
+			data:    "GB82WEST12345698765433",
+			code:    CodeIBAN,
+			message: "failed <string> validation for <IBAN>",
+		},
+		{
+			name:    "String.ISBN",
+			schema:  func(msg ...string) Validatable { return String().ISBN(msg...) },
+			data:    "0804429570",
+			code:    CodeISBN,
+			message: "failed <string> validation for <ISBN>",
+		},
+		{
+			name:    "String.ISBN10",
+			schema:  func(msg ...string) Validatable { return String().ISBN10(msg...) },
+			data:    "9780306406157",
+			code:    CodeISBN,
+			message: "failed <string> validation for <ISBN10>",
+		},
+		{
+			name:    "String.ISBN13",
+			schema:  func(msg ...string) Validatable { return String().ISBN13(msg...) },
+			data:    "080442957X",
+			code:    CodeISBN,
+			message: "failed <string> validation for <ISBN13>",
+		},
+		{
+			name:    "String.EAN",
+			schema:  func(msg ...string) Validatable { return String().EAN(msg...) },
+			data:    "96385075",
+			code:    CodeEAN,
+			message: "failed <string> validation for <EAN>",
+		},
+		{
+			name:    "String.UPC",
+			schema:  func(msg ...string) Validatable { return String().UPC(msg...) },
+			data:    "036000291453",
+			code:    CodeUPC,
+			message: "failed <string> validation for <UPC>",
+		},
+		{
+			name:    "String.E164",
+			schema:  func(msg ...string) Validatable { return String().E164(msg...) },
+			data:    "14155552671",
+			code:    CodeE164,
+			message: "failed <string> validation for <E164>",
+		},
+		{
+			name:    "String.UUID",
+			schema:  func(msg ...string) Validatable { return String().UUID(msg...) },
+			data:    "not-a-uuid",
+			code:    CodeUUID,
+			message: "failed <string> validation for <UUID>",
+		},
+		{
+			name:    "String.UUIDVersion",
+			schema:  func(msg ...string) Validatable { return String().UUIDVersion(4, msg...) },
+			data:    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			code:    CodeUUID,
+			message: "failed <string> validation for <UUID(4)>",
+		},
+		{
+			name:    "String.URL",
+			schema:  func(msg ...string) Validatable { return String().URL(msg...) },
+			data:    "example.com",
+			code:    CodeURL,
+			message: "failed <string> validation for <URL>",
+		},
+		{
+			name:    "String.URLScheme",
+			schema:  func(msg ...string) Validatable { return String().URLScheme([]string{"https"}, msg...) },
+			data:    "http://example.com",
+			code:    CodeURLScheme,
+			message: "failed <string> validation for <URLScheme([https])>",
+		},
+		{
+			name:    "String.URLHost",
+			schema:  func(msg ...string) Validatable { return String().URLHost([]string{"example.com"}, msg...) },
+			data:    "https://example.org",
+			code:    CodeURLHost,
+			message: "failed <string> validation for <URLHost([example.com])>",
+		},
+		{
+			name:    "String.IP",
+			schema:  func(msg ...string) Validatable { return String().IP(msg...) },
+			data:    "999.1.1.1",
+			code:    CodeIP,
+			message: "failed <string> validation for <IP>",
+		},
+		{
+			name:    "String.IPv4",
+			schema:  func(msg ...string) Validatable { return String().IPv4(msg...) },
+			data:    "::1",
+			code:    CodeIPv4,
+			message: "failed <string> validation for <IPv4>",
+		},
+		{
+			name:    "String.IPv6",
+			schema:  func(msg ...string) Validatable { return String().IPv6(msg...) },
+			data:    "127.0.0.1",
+			code:    CodeIPv6,
+			message: "failed <string> validation for <IPv6>",
+		},
+		{
+			name:    "String.CIDR",
+			schema:  func(msg ...string) Validatable { return String().CIDR(msg...) },
+			data:    "10.0.0.0",
+			code:    CodeCIDR,
+			message: "failed <string> validation for <CIDR>",
+		},
+		{
+			name:    "String.Hostname",
+			schema:  func(msg ...string) Validatable { return String().Hostname(msg...) },
+			data:    "-bad-.com",
+			code:    CodeHostname,
+			message: "failed <string> validation for <Hostname>",
+		},
+		{
+			name:    "String.HostPort",
+			schema:  func(msg ...string) Validatable { return String().HostPort(msg...) },
+			data:    "localhost",
+			code:    CodeHostPort,
+			message: "failed <string> validation for <HostPort>",
+		},
+		{
+			name:    "String.MAC",
+			schema:  func(msg ...string) Validatable { return String().MAC(msg...) },
+			data:    "00:11",
+			code:    CodeMAC,
+			message: "failed <string> validation for <MAC>",
+		},
+		{
+			name:    "String.Port",
+			schema:  func(msg ...string) Validatable { return String().Port(msg...) },
+			data:    "70000",
+			code:    CodePort,
+			message: "failed <string> validation for <Port>",
+		},
+		{
+			name:    "String.NoInvisible",
+			schema:  func(msg ...string) Validatable { return String().NoInvisible(msg...) },
+			data:    "a\u200bb",
+			code:    CodeNoInvisible,
+			message: "failed <string> validation for <NoInvisible>",
+		},
+		{
+			name:    "String.NoMixedScripts",
+			schema:  func(msg ...string) Validatable { return String().NoMixedScripts(msg...) },
+			data:    "p\u0430ypal",
+			code:    CodeNoMixedScripts,
+			message: "failed <string> validation for <NoMixedScripts>",
+		},
+		{
+			name: "String.SkeletonCtx",
+			schema: func(msg ...string) Validatable {
+				return String().SkeletonCtx(func(context.Context, string) error { return ErrInvalid }, msg...)
+			},
+			data:    "paypal",
+			code:    CodeSkeleton,
+			message: "failed <string> validation for <SkeletonCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "String.Password/MinLength",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{MinLength: 8}, msg...) },
+			data:    "short",
+			code:    CodePasswordLength,
+			message: "failed <string> validation for <Password(MinLength(8))>",
+		},
+		{
+			name:    "String.Password/RequireLower",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{RequireLower: true}, msg...) },
+			data:    "ABC",
+			code:    CodePasswordClasses,
+			message: "failed <string> validation for <Password(RequireLower)>",
+		},
+		{
+			name:    "String.Password/RequireUpper",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{RequireUpper: true}, msg...) },
+			data:    "abc",
+			code:    CodePasswordClasses,
+			message: "failed <string> validation for <Password(RequireUpper)>",
+		},
+		{
+			name:    "String.Password/RequireDigit",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{RequireDigit: true}, msg...) },
+			data:    "abc",
+			code:    CodePasswordClasses,
+			message: "failed <string> validation for <Password(RequireDigit)>",
+		},
+		{
+			name:    "String.Password/RequireSymbol",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{RequireSymbol: true}, msg...) },
+			data:    "abc",
+			code:    CodePasswordClasses,
+			message: "failed <string> validation for <Password(RequireSymbol)>",
+		},
+		{
+			name:    "String.Password/MinClasses",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{MinClasses: 2}, msg...) },
+			data:    "abc",
+			code:    CodePasswordClasses,
+			message: "failed <string> validation for <Password(MinClasses(2))>",
+		},
+		{
+			name:    "String.Password/MinEntropy",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{MinEntropy: 40}, msg...) },
+			data:    "abc",
+			code:    CodePasswordEntropy,
+			message: "failed <string> validation for <Password(MinEntropy(40))>",
+		},
+		{
+			name:    "String.Password/MaxRepeats",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{MaxRepeats: 2}, msg...) },
+			data:    "aaab",
+			code:    CodePasswordRepeats,
+			message: "failed <string> validation for <Password(MaxRepeats(2))>",
+		},
+		{
+			name:    "String.Password/MaxSequence",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{MaxSequence: 3}, msg...) },
+			data:    "abcd",
+			code:    CodePasswordSequence,
+			message: "failed <string> validation for <Password(MaxSequence(3))>",
+		},
+		{
+			name:    "String.Password/Breached",
+			schema:  func(msg ...string) Validatable { return String().Password(PasswordPolicy{Breached: breached}, msg...) },
+			data:    "password",
+			code:    CodePasswordBreached,
+			message: "failed <string> validation for <Password(Breached)>",
+		},
+		{
+			name:    "String.JSON",
+			schema:  func(msg ...string) Validatable { return String().JSON(msg...) },
+			data:    "{",
+			code:    CodeJSON,
+			message: "failed <string> validation for <JSON> (unexpected end of JSON input at line 1, column 2)",
+			params:  map[string]any{"column": 2, "line": 1, "offset": 1},
+		},
+		{
+			name: "String.JSONOf",
+			schema: func(msg ...string) Validatable {
+				return String().JSONOf(func() any {
+					return &struct {
+						N int `z:"n"`
+					}{}
+				}, Struct{"n": Int().Positive(msg...)})
+			},
+			data:    `{"n":-1}`,
+			code:    CodePositive,
+			path:    "n",
+			message: "<n> failed <int> validation for <Positive>",
+		},
+		{
+			name: "String.JSONOf/not JSON",
+			schema: func(msg ...string) Validatable {
+				return String().JSONOf(func() any {
+					return &struct {
+						N int `z:"n"`
+					}{}
+				}, Struct{"n": Int().Positive()}, msg...)
+			},
+			data:    `{"n":`,
+			code:    CodeJSON,
+			message: "failed <string> validation for <JSON> (unexpected end of JSON input at line 1, column 6)",
+			params:  map[string]any{"column": 6, "line": 1, "offset": 5},
+		},
+		{
+			name:    "String.Base64",
+			schema:  func(msg ...string) Validatable { return String().Base64(msg...) },
+			data:    "!!",
+			code:    CodeBase64,
+			message: "failed <string> validation for <Base64> (invalid at offset 0)",
+			params:  map[string]any{"offset": 0},
+		},
+		{
+			name:    "String.Base64URL",
+			schema:  func(msg ...string) Validatable { return String().Base64URL(msg...) },
+			data:    "+/==",
+			code:    CodeBase64,
+			message: "failed <string> validation for <Base64URL> (invalid at offset 0)",
+			params:  map[string]any{"offset": 0},
+		},
+		{
+			name:    "String.Hex",
+			schema:  func(msg ...string) Validatable { return String().Hex(msg...) },
+			data:    "xyz",
+			code:    CodeHex,
+			message: "failed <string> validation for <Hex> (invalid at offset 0)",
+			params:  map[string]any{"offset": 0},
+		},
+		{
+			name:    "String.DecodedLen",
+			schema:  func(msg ...string) Validatable { return String().DecodedLen(EncodingHex, 2, 4, msg...) },
+			data:    "ab",
+			code:    CodeDecodedLen,
+			message: "failed <string> validation for <DecodedLen(Hex, 2, 4)>",
+			params:  map[string]any{"encoding": "Hex", "max": 4, "min": 2},
+		},
+		{
+			name:    "String.SemVer",
+			schema:  func(msg ...string) Validatable { return String().SemVer(msg...) },
+			data:    "1.0",
+			code:    CodeSemVer,
+			message: "failed <string> validation for <SemVer> (expected \".\", found end at offset 3)",
+			params:  map[string]any{"offset": 3},
+		},
+		{
+			name:    "String.SemVerRange",
+			schema:  func(msg ...string) Validatable { return String().SemVerRange("^1.2.3", msg...) },
+			data:    "2.0.0",
+			code:    CodeSemVerRange,
+			message: "failed <string> validation for <SemVerRange(^1.2.3)>",
+		},
+		{
+			name:    "String.Cron",
+			schema:  func(msg ...string) Validatable { return String().Cron(msg...) },
+			data:    "* * *",
+			code:    CodeCron,
+			message: "failed <string> validation for <Cron> (expected 5 or 6 fields, found 3 at offset 5)",
+			params:  map[string]any{"field": "", "offset": 5},
+		},
+		{
+			name:    "String.RFC3339",
+			schema:  func(msg ...string) Validatable { return String().RFC3339(msg...) },
+			data:    "2020-01-01",
+			code:    CodeRFC3339,
+			message: "failed <string> validation for <RFC3339>",
+			params:  map[string]any{"layout": "2006-01-02T15:04:05.999999999Z07:00"},
+		},
+		{
+			name:    "String.Date",
+			schema:  func(msg ...string) Validatable { return String().Date(msg...) },
+			data:    "2020-13-01",
+			code:    CodeDate,
+			message: "failed <string> validation for <Date>",
+			params:  map[string]any{"layout": "2006-01-02"},
+		},
+		{
+			name:    "String.Layout",
+			schema:  func(msg ...string) Validatable { return String().Layout("15:04", msg...) },
+			data:    "25:00",
+			code:    CodeLayout,
+			message: "failed <string> validation for <Layout(15:04)>",
+			params:  map[string]any{"layout": "15:04"},
+		},
+		{
+			name:    "String.Timezone",
+			schema:  func(msg ...string) Validatable { return String().Timezone(msg...) },
+			data:    "Mars/Olympus",
+			code:    CodeTimezone,
+			message: "failed <string> validation for <Timezone>",
+		},
+		{
+			name: "String.Before",
+			schema: func(msg ...string) Validatable {
+				return String().RFC3339().Before(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), msg...)
+			},
+			data:    "2021-01-01T00:00:00Z",
+			code:    CodeBefore,
+			message: "failed <string> validation for <Before(2020-01-01T00:00:00Z)>",
+			params:  map[string]any{"time": time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "String.After",
+			schema: func(msg ...string) Validatable {
+				return String().RFC3339().After(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), msg...)
+			},
+			data:    "2019-01-01T00:00:00Z",
+			code:    CodeAfter,
+			message: "failed <string> validation for <After(2020-01-01T00:00:00Z)>",
+			params:  map[string]any{"time": time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "String.BeforeNow",
+			schema:   func(msg ...string) Validatable { return String().RFC3339().BeforeNow(msg...) },
+			data:     "2999-01-01T00:00:00Z",
+			code:     CodeBefore,
+			message:  "failed <string> validation for <BeforeNow>",
+			volatile: true,
+		},
+		{
+			name:     "String.AfterNow",
+			schema:   func(msg ...string) Validatable { return String().RFC3339().AfterNow(msg...) },
+			data:     "2000-01-01T00:00:00Z",
+			code:     CodeAfter,
+			message:  "failed <string> validation for <AfterNow>",
+			volatile: true,
+		},
+		{
+			name:    "String.ASCII",
+			schema:  func(msg ...string) Validatable { return String().ASCII(msg...) },
+			data:    "caf\u00e9",
+			code:    CodeASCII,
+			message: "failed <string> validation for <ASCII>",
+		},
+		{
+			name:    "String.Printable",
+			schema:  func(msg ...string) Validatable { return String().Printable(msg...) },
+			data:    "a\tb",
+			code:    CodePrintable,
+			message: "failed <string> validation for <Printable>",
+		},
+		{
+			name:    "String.NoControlChars",
+			schema:  func(msg ...string) Validatable { return String().NoControlChars(msg...) },
+			data:    "a\x00b",
+			code:    CodeNoControlChars,
+			message: "failed <string> validation for <NoControlChars>",
+		},
+		{
+			name:    "String.Alpha",
+			schema:  func(msg ...string) Validatable { return String().Alpha(msg...) },
+			data:    "a1",
+			code:    CodeAlpha,
+			message: "failed <string> validation for <Alpha>",
+		},
+		{
+			name:    "String.Alphanumeric",
+			schema:  func(msg ...string) Validatable { return String().Alphanumeric(msg...) },
+			data:    "a-1",
+			code:    CodeAlphanumeric,
+			message: "failed <string> validation for <Alphanumeric>",
+		},
+		{
+			name:    "String.Scripts",
+			schema:  func(msg ...string) Validatable { return String().Scripts([]string{"Latin"}, msg...) },
+			data:    "\u0436",
+			code:    CodeScripts,
+			message: "failed <string> validation for <Scripts([Latin])>",
+		},
+		{
+			name:    "String.UTF8",
+			schema:  func(msg ...string) Validatable { return String().UTF8(msg...) },
+			data:    "\xff",
+			code:    CodeUTF8,
+			message: "failed <string> validation for <UTF8>",
+		},
+		{
+			name:    "String.CountryAlpha2",
+			schema:  func(msg ...string) Validatable { return String().CountryAlpha2(msg...) },
+			data:    "XX",
+			code:    CodeCountryAlpha2,
+			message: "failed <string> validation for <CountryAlpha2>",
+		},
+		{
+			name:    "String.CountryAlpha3",
+			schema:  func(msg ...string) Validatable { return String().CountryAlpha3(msg...) },
+			data:    "XXX",
+			code:    CodeCountryAlpha3,
+			message: "failed <string> validation for <CountryAlpha3>",
+		},
+		{
+			name:    "String.CountryNumeric",
+			schema:  func(msg ...string) Validatable { return String().CountryNumeric(msg...) },
+			data:    "999",
+			code:    CodeCountryNumeric,
+			message: "failed <string> validation for <CountryNumeric>",
+		},
+		{
+			name:    "String.Subdivision",
+			schema:  func(msg ...string) Validatable { return String().Subdivision(msg...) },
+			data:    "US-XX",
+			code:    CodeSubdivision,
+			message: "failed <string> validation for <Subdivision>",
+		},
+		{
+			name:    "String.LanguageTag",
+			schema:  func(msg ...string) Validatable { return String().LanguageTag(msg...) },
+			data:    "not a tag",
+			code:    CodeLanguageTag,
+			message: "failed <string> validation for <LanguageTag>",
+		},
+		{
+			name:    "String.LanguageAlpha2",
+			schema:  func(msg ...string) Validatable { return String().LanguageAlpha2(msg...) },
+			data:    "xx",
+			code:    CodeLanguageAlpha2,
+			message: "failed <string> validation for <LanguageAlpha2>",
+		},
+		{
+			name:    "String.LanguageAlpha3",
+			schema:  func(msg ...string) Validatable { return String().LanguageAlpha3(msg...) },
+			data:    "xxx",
+			code:    CodeLanguageAlpha3,
+			message: "failed <string> validation for <LanguageAlpha3>",
+		},
+		{
+			name:    "String.Currency",
+			schema:  func(msg ...string) Validatable { return String().Currency(msg...) },
+			data:    "ABC",
+			code:    CodeCurrency,
+			message: "failed <string> validation for <Currency>",
+		},
+		{
+			name:    "Int.Lt",
+			schema:  func(msg ...string) Validatable { return Int().Lt(5, msg...) },
+			data:    5,
+			code:    CodeLt,
+			message: "failed <int> validation for <Lt(5)>",
+		},
+		{
+			name:    "Int.Gt",
+			schema:  func(msg ...string) Validatable { return Int().Gt(5, msg...) },
+			data:    5,
+			code:    CodeGt,
+			message: "failed <int> validation for <Gt(5)>",
+		},
+		{
+			name:    "Int.Lte",
+			schema:  func(msg ...string) Validatable { return Int().Lte(5, msg...) },
+			data:    6,
+			code:    CodeLte,
+			message: "failed <int> validation for <Lte(5)>",
+		},
+		{
+			name:    "Int.Gte",
+			schema:  func(msg ...string) Validatable { return Int().Gte(5, msg...) },
+			data:    4,
+			code:    CodeGte,
+			message: "failed <int> validation for <Gte(5)>",
+		},
+		{
+			name:    "Int.Range",
+			schema:  func(msg ...string) Validatable { return Int().Range(1, 5, msg...) },
+			data:    6,
+			code:    CodeRange,
+			message: "failed <int> validation for <Range(1, 5)>",
+		},
+		{
+			name:    "Int.Eq",
+			schema:  func(msg ...string) Validatable { return Int().Eq(5, msg...) },
+			data:    4,
+			code:    CodeEq,
+			message: "failed <int> validation for <Eq(5)>",
+		},
+		{
+			name:    "Int.NotEq",
+			schema:  func(msg ...string) Validatable { return Int().NotEq(5, msg...) },
+			data:    5,
+			code:    CodeNotEq,
+			message: "failed <int> validation for <NotEq(5)>",
+		},
+		{
+			name:    "Int.Positive",
+			schema:  func(msg ...string) Validatable { return Int().Positive(msg...) },
+			data:    0,
+			code:    CodePositive,
+			message: "failed <int> validation for <Positive>",
+		},
+		{
+			name:    "Int.Negative",
+			schema:  func(msg ...string) Validatable { return Int().Negative(msg...) },
+			data:    0,
+			code:    CodeNegative,
+			message: "failed <int> validation for <Negative>",
+		},
+		{
+			name:    "Int.NonNegative",
+			schema:  func(msg ...string) Validatable { return Int().NonNegative(msg...) },
+			data:    -1,
+			code:    CodeNonNegative,
+			message: "failed <int> validation for <NonNegative>",
+		},
+		{
+			name:    "Int.NonPositive",
+			schema:  func(msg ...string) Validatable { return Int().NonPositive(msg...) },
+			data:    1,
+			code:    CodeNonPositive,
+			message: "failed <int> validation for <NonPositive>",
+		},
+		{
+			name:    "Int.NonZero",
+			schema:  func(msg ...string) Validatable { return Int().NonZero(msg...) },
+			data:    0,
+			code:    CodeNonZero,
+			message: "failed <int> validation for <NonZero>",
+		},
+		{
+			name:    "Int.In",
+			schema:  func(msg ...string) Validatable { return Int().In([]int{1, 2}, msg...) },
+			data:    3,
+			code:    CodeIn,
+			message: "failed <int> validation for <In([1 2])>",
+		},
+		{
+			name:    "Int.MultipleOf",
+			schema:  func(msg ...string) Validatable { return Int().MultipleOf(3, msg...) },
+			data:    4,
+			code:    CodeMultipleOf,
+			message: "failed <int> validation for <MultipleOf(3)>",
+			params:  map[string]any{"step": 3},
+		},
+		{
+			name:    "Int.Step",
+			schema:  func(msg ...string) Validatable { return Int().Step(5, 1, msg...) },
+			data:    5,
+			code:    CodeStep,
+			message: "failed <int> validation for <Step(5, 1)>",
+			params:  map[string]any{"offset": 1, "step": 5},
+		},
+		{
+			name:    "Int.Even",
+			schema:  func(msg ...string) Validatable { return Int().Even(msg...) },
+			data:    3,
+			code:    CodeEven,
+			message: "failed <int> validation for <Even>",
+		},
+		{
+			name:    "Int.Odd",
+			schema:  func(msg ...string) Validatable { return Int().Odd(msg...) },
+			data:    4,
+			code:    CodeOdd,
+			message: "failed <int> validation for <Odd>",
+		},
+		{
+			name:    "Int.PowerOfTwo",
+			schema:  func(msg ...string) Validatable { return Int().PowerOfTwo(msg...) },
+			data:    6,
+			code:    CodePowerOfTwo,
+			message: "failed <int> validation for <PowerOfTwo>",
+		},
+		{
+			name:    "Int.Flags",
+			schema:  func(msg ...string) Validatable { return Int().Flags(0b0110, msg...) },
+			data:    1,
+			code:    CodeFlags,
+			message: "failed <int> validation for <Flags(0x6)>",
+			params:  map[string]any{"mask": 6, "unknown": 1},
+		},
+		{
+			name:    "Int.Custom",
+			schema:  func(msg ...string) Validatable { return Int().Custom(func(int) bool { return false }, msg...) },
+			data:    1,
+			code:    CodeCustom,
+			message: "failed <int> validation for <Custom>",
+		},
+		{
+			name: "Int.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return Int().CustomCtx(func(context.Context, int) error { return ErrInvalid }, msg...)
+			},
+			data:    1,
+			code:    CodeCustomCtx,
+			message: "failed <int> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "Int8.Gt",
+			schema:  func(msg ...string) Validatable { return Int8().Gt(5, msg...) },
+			data:    int8(5),
+			code:    CodeGt,
+			message: "failed <int8> validation for <Gt(5)>",
+		},
+		{
+			name:    "Uint.Lt",
+			schema:  func(msg ...string) Validatable { return Uint().Lt(5, msg...) },
+			data:    uint(5),
+			code:    CodeLt,
+			message: "failed <uint> validation for <Lt(5)>",
+		},
+		{
+			name:    "Uint.Gt",
+			schema:  func(msg ...string) Validatable { return Uint().Gt(5, msg...) },
+			data:    uint(5),
+			code:    CodeGt,
+			message: "failed <uint> validation for <Gt(5)>",
+		},
+		{
+			name:    "Uint.Lte",
+			schema:  func(msg ...string) Validatable { return Uint().Lte(5, msg...) },
+			data:    uint(6),
+			code:    CodeLte,
+			message: "failed <uint> validation for <Lte(5)>",
+		},
+		{
+			name:    "Uint.Gte",
+			schema:  func(msg ...string) Validatable { return Uint().Gte(5, msg...) },
+			data:    uint(4),
+			code:    CodeGte,
+			message: "failed <uint> validation for <Gte(5)>",
+		},
+		{
+			name:    "Uint.Range",
+			schema:  func(msg ...string) Validatable { return Uint().Range(1, 5, msg...) },
+			data:    uint(6),
+			code:    CodeRange,
+			message: "failed <uint> validation for <Range(1, 5)>",
+		},
+		{
+			name:    "Uint.Eq",
+			schema:  func(msg ...string) Validatable { return Uint().Eq(5, msg...) },
+			data:    uint(4),
+			code:    CodeEq,
+			message: "failed <uint> validation for <Eq(5)>",
+		},
+		{
+			name:    "Uint.NotEq",
+			schema:  func(msg ...string) Validatable { return Uint().NotEq(5, msg...) },
+			data:    uint(5),
+			code:    CodeNotEq,
+			message: "failed <uint> validation for <NotEq(5)>",
+		},
+		{
+			name:    "Uint.NonZero",
+			schema:  func(msg ...string) Validatable { return Uint().NonZero(msg...) },
+			data:    uint(0),
+			code:    CodeNonZero,
+			message: "failed <uint> validation for <NonZero>",
+		},
+		{
+			name:    "Uint.In",
+			schema:  func(msg ...string) Validatable { return Uint().In([]uint{1, 2}, msg...) },
+			data:    uint(3),
+			code:    CodeIn,
+			message: "failed <uint> validation for <In([1 2])>",
+		},
+		{
+			name:    "Uint.MultipleOf",
+			schema:  func(msg ...string) Validatable { return Uint().MultipleOf(3, msg...) },
+			data:    uint(4),
+			code:    CodeMultipleOf,
+			message: "failed <uint> validation for <MultipleOf(3)>",
+			params:  map[string]any{"step": uint(3)},
+		},
+		{
+			name:    "Uint.Step",
+			schema:  func(msg ...string) Validatable { return Uint().Step(5, 1, msg...) },
+			data:    uint(5),
+			code:    CodeStep,
+			message: "failed <uint> validation for <Step(5, 1)>",
+			params:  map[string]any{"offset": uint(1), "step": uint(5)},
+		},
+		{
+			name:    "Uint.Even",
+			schema:  func(msg ...string) Validatable { return Uint().Even(msg...) },
+			data:    uint(3),
+			code:    CodeEven,
+			message: "failed <uint> validation for <Even>",
+		},
+		{
+			name:    "Uint.Odd",
+			schema:  func(msg ...string) Validatable { return Uint().Odd(msg...) },
+			data:    uint(4),
+			code:    CodeOdd,
+			message: "failed <uint> validation for <Odd>",
+		},
+		{
+			name:    "Uint.PowerOfTwo",
+			schema:  func(msg ...string) Validatable { return Uint().PowerOfTwo(msg...) },
+			data:    uint(6),
+			code:    CodePowerOfTwo,
+			message: "failed <uint> validation for <PowerOfTwo>",
+		},
+		{
+			name:    "Uint.Flags",
+			schema:  func(msg ...string) Validatable { return Uint().Flags(0b0110, msg...) },
+			data:    uint(1),
+			code:    CodeFlags,
+			message: "failed <uint> validation for <Flags(0x6)>",
+			params:  map[string]any{"mask": uint(6), "unknown": uint(1)},
+		},
+		{
+			name:    "Uint.Custom",
+			schema:  func(msg ...string) Validatable { return Uint().Custom(func(uint) bool { return false }, msg...) },
+			data:    uint(1),
+			code:    CodeCustom,
+			message: "failed <uint> validation for <Custom>",
+		},
+		{
+			name: "Uint.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return Uint().CustomCtx(func(context.Context, uint) error { return ErrInvalid }, msg...)
+			},
+			data:    uint(1),
+			code:    CodeCustomCtx,
+			message: "failed <uint> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "Uint8.Gt",
+			schema:  func(msg ...string) Validatable { return Uint8().Gt(5, msg...) },
+			data:    uint8(5),
+			code:    CodeGt,
+			message: "failed <uint8> validation for <Gt(5)>",
+		},
+		{
+			name:    "Float64.Lt",
+			schema:  func(msg ...string) Validatable { return Float64().Lt(5, msg...) },
+			data:    float64(5),
+			code:    CodeLt,
+			message: "failed <float64> validation for <Lt(5)>",
+		},
+		{
+			name:    "Float64.Gt",
+			schema:  func(msg ...string) Validatable { return Float64().Gt(5, msg...) },
+			data:    float64(5),
+			code:    CodeGt,
+			message: "failed <float64> validation for <Gt(5)>",
+		},
+		{
+			name:    "Float64.Lte",
+			schema:  func(msg ...string) Validatable { return Float64().Lte(5, msg...) },
+			data:    float64(6),
+			code:    CodeLte,
+			message: "failed <float64> validation for <Lte(5)>",
+		},
+		{
+			name:    "Float64.Gte",
+			schema:  func(msg ...string) Validatable { return Float64().Gte(5, msg...) },
+			data:    float64(4),
+			code:    CodeGte,
+			message: "failed <float64> validation for <Gte(5)>",
+		},
+		{
+			name:    "Float64.Range",
+			schema:  func(msg ...string) Validatable { return Float64().Range(1, 5, msg...) },
+			data:    float64(6),
+			code:    CodeRange,
+			message: "failed <float64> validation for <Range(1, 5)>",
+		},
+		{
+			name:    "Float64.Eq",
+			schema:  func(msg ...string) Validatable { return Float64().Eq(5, msg...) },
+			data:    float64(4),
+			code:    CodeEq,
+			message: "failed <float64> validation for <Eq(5)>",
+		},
+		{
+			name:    "Float64.NotEq",
+			schema:  func(msg ...string) Validatable { return Float64().NotEq(5, msg...) },
+			data:    float64(5),
+			code:    CodeNotEq,
+			message: "failed <float64> validation for <NotEq(5)>",
+		},
+		{
+			name:    "Float64.Positive",
+			schema:  func(msg ...string) Validatable { return Float64().Positive(msg...) },
+			data:    float64(0),
+			code:    CodePositive,
+			message: "failed <float64> validation for <Positive>",
+		},
+		{
+			name:    "Float64.Negative",
+			schema:  func(msg ...string) Validatable { return Float64().Negative(msg...) },
+			data:    float64(0),
+			code:    CodeNegative,
+			message: "failed <float64> validation for <Negative>",
+		},
+		{
+			name:    "Float64.NonNegative",
+			schema:  func(msg ...string) Validatable { return Float64().NonNegative(msg...) },
+			data:    float64(-1),
+			code:    CodeNonNegative,
+			message: "failed <float64> validation for <NonNegative>",
+		},
+		{
+			name:    "Float64.NonPositive",
+			schema:  func(msg ...string) Validatable { return Float64().NonPositive(msg...) },
+			data:    float64(1),
+			code:    CodeNonPositive,
+			message: "failed <float64> validation for <NonPositive>",
+		},
+		{
+			name:    "Float64.NonZero",
+			schema:  func(msg ...string) Validatable { return Float64().NonZero(msg...) },
+			data:    float64(0),
+			code:    CodeNonZero,
+			message: "failed <float64> validation for <NonZero>",
+		},
+		{
+			name:    "Float64.In",
+			schema:  func(msg ...string) Validatable { return Float64().In([]float64{1.5, 2.5}, msg...) },
+			data:    float64(3),
+			code:    CodeIn,
+			message: "failed <float64> validation for <In([1.5 2.5])>",
+		},
+		{
+			name:    "Float64.Finite",
+			schema:  func(msg ...string) Validatable { return Float64().Finite(msg...) },
+			data:    math.Inf(1),
+			code:    CodeFinite,
+			message: "failed <float64> validation for <Finite>",
+		},
+		{
+			name:    "Float64.NotNaN",
+			schema:  func(msg ...string) Validatable { return Float64().NotNaN(msg...) },
+			data:    math.NaN(),
+			code:    CodeNotNaN,
+			message: "failed <float64> validation for <NotNaN>",
+		},
+		{
+			name:    "Float64.MaxDecimalPlaces",
+			schema:  func(msg ...string) Validatable { return Float64().MaxDecimalPlaces(2, msg...) },
+			data:    float64(1.234),
+			code:    CodeMaxDecimalPlaces,
+			message: "failed <float64> validation for <MaxDecimalPlaces(2)>",
+			params:  map[string]any{"places": 2},
+		},
+		{
+			name:    "Float64.Precision",
+			schema:  func(msg ...string) Validatable { return Float64().Precision(3, msg...) },
+			data:    float64(1234),
+			code:    CodePrecision,
+			message: "failed <float64> validation for <Precision(3)>",
+			params:  map[string]any{"digits": 3},
+		},
+		{
+			name:    "Float64.MultipleOf",
+			schema:  func(msg ...string) Validatable { return Float64().MultipleOf(0.5, 1e-9, msg...) },
+			data:    float64(0.3),
+			code:    CodeMultipleOf,
+			message: "failed <float64> validation for <MultipleOf(0.5, 1e-09)>",
+			params:  map[string]any{"epsilon": 1e-09, "step": 0.5},
+		},
+		{
+			name:    "Float64.EqApprox",
+			schema:  func(msg ...string) Validatable { return Float64().EqApprox(1, 0.01, msg...) },
+			data:    float64(1.1),
+			code:    CodeEqApprox,
+			message: "failed <float64> validation for <EqApprox(1, 0.01)>",
+			params:  map[string]any{"tolerance": 0.01, "value": float64(1)},
+		},
+		{
+			name:    "Float64.Latitude",
+			schema:  func(msg ...string) Validatable { return Float64().Latitude(msg...) },
+			data:    float64(91),
+			code:    CodeLatitude,
+			message: "failed <float64> validation for <Latitude>",
+		},
+		{
+			name:    "Float64.Longitude",
+			schema:  func(msg ...string) Validatable { return Float64().Longitude(msg...) },
+			data:    float64(181),
+			code:    CodeLongitude,
+			message: "failed <float64> validation for <Longitude>",
+		},
+		{
+			name:    "Float64.Custom",
+			schema:  func(msg ...string) Validatable { return Float64().Custom(func(float64) bool { return false }, msg...) },
+			data:    float64(1),
+			code:    CodeCustom,
+			message: "failed <float64> validation for <Custom>",
+		},
+		{
+			name: "Float64.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return Float64().CustomCtx(func(context.Context, float64) error { return ErrInvalid }, msg...)
+			},
+			data:    float64(1),
+			code:    CodeCustomCtx,
+			message: "failed <float64> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "Float32.Gt",
+			schema:  func(msg ...string) Validatable { return Float32().Gt(5, msg...) },
+			data:    float32(5),
+			code:    CodeGt,
+			message: "failed <float32> validation for <Gt(5)>",
+		},
+		{
+			name:    "Bool.True",
+			schema:  func(msg ...string) Validatable { return Bool().True(msg...) },
+			data:    false,
+			code:    CodeTrue,
+			message: "failed <bool> validation for <True>",
+		},
+		{
+			name:    "Bool.False",
+			schema:  func(msg ...string) Validatable { return Bool().False(msg...) },
+			data:    true,
+			code:    CodeFalse,
+			message: "failed <bool> validation for <False>",
+		},
+		{
+			name:    "BigInt.Lt",
+			schema:  func(msg ...string) Validatable { return BigInt().Lt(big.NewInt(5), msg...) },
+			data:    big.NewInt(5),
+			code:    CodeLt,
+			message: "failed <big.Int> validation for <Lt(5)>",
+		},
+		{
+			name:    "BigInt.Gt",
+			schema:  func(msg ...string) Validatable { return BigInt().Gt(big.NewInt(5), msg...) },
+			data:    big.NewInt(5),
+			code:    CodeGt,
+			message: "failed <big.Int> validation for <Gt(5)>",
+		},
+		{
+			name:    "BigInt.Lte",
+			schema:  func(msg ...string) Validatable { return BigInt().Lte(big.NewInt(5), msg...) },
+			data:    big.NewInt(6),
+			code:    CodeLte,
+			message: "failed <big.Int> validation for <Lte(5)>",
+		},
+		{
+			name:    "BigInt.Gte",
+			schema:  func(msg ...string) Validatable { return BigInt().Gte(big.NewInt(5), msg...) },
+			data:    big.NewInt(4),
+			code:    CodeGte,
+			message: "failed <big.Int> validation for <Gte(5)>",
+		},
+		{
+			name:    "BigInt.Range",
+			schema:  func(msg ...string) Validatable { return BigInt().Range(big.NewInt(1), big.NewInt(5), msg...) },
+			data:    big.NewInt(6),
+			code:    CodeRange,
+			message: "failed <big.Int> validation for <Range(1, 5)>",
+		},
+		{
+			name:    "BigInt.Eq",
+			schema:  func(msg ...string) Validatable { return BigInt().Eq(big.NewInt(5), msg...) },
+			data:    big.NewInt(4),
+			code:    CodeEq,
+			message: "failed <big.Int> validation for <Eq(5)>",
+		},
+		{
+			name:    "BigInt.NotEq",
+			schema:  func(msg ...string) Validatable { return BigInt().NotEq(big.NewInt(5), msg...) },
+			data:    big.NewInt(5),
+			code:    CodeNotEq,
+			message: "failed <big.Int> validation for <NotEq(5)>",
+		},
+		{
+			name:    "BigInt.Positive",
+			schema:  func(msg ...string) Validatable { return BigInt().Positive(msg...) },
+			data:    big.NewInt(0),
+			code:    CodePositive,
+			message: "failed <big.Int> validation for <Positive>",
+		},
+		{
+			name:    "BigInt.Negative",
+			schema:  func(msg ...string) Validatable { return BigInt().Negative(msg...) },
+			data:    big.NewInt(0),
+			code:    CodeNegative,
+			message: "failed <big.Int> validation for <Negative>",
+		},
+		{
+			name:    "BigInt.NonNegative",
+			schema:  func(msg ...string) Validatable { return BigInt().NonNegative(msg...) },
+			data:    big.NewInt(-1),
+			code:    CodeNonNegative,
+			message: "failed <big.Int> validation for <NonNegative>",
+		},
+		{
+			name:    "BigInt.NonPositive",
+			schema:  func(msg ...string) Validatable { return BigInt().NonPositive(msg...) },
+			data:    big.NewInt(1),
+			code:    CodeNonPositive,
+			message: "failed <big.Int> validation for <NonPositive>",
+		},
+		{
+			name:    "BigInt.NonZero",
+			schema:  func(msg ...string) Validatable { return BigInt().NonZero(msg...) },
+			data:    big.NewInt(0),
+			code:    CodeNonZero,
+			message: "failed <big.Int> validation for <NonZero>",
+		},
+		{
+			name:    "BigInt.Precision",
+			schema:  func(msg ...string) Validatable { return BigInt().Precision(2, msg...) },
+			data:    big.NewInt(123),
+			code:    CodePrecision,
+			message: "failed <big.Int> validation for <Precision(2)>",
+			params:  map[string]any{"digits": 2},
+		},
+		{
+			name:    "BigInt.Custom",
+			schema:  func(msg ...string) Validatable { return BigInt().Custom(func(*big.Int) bool { return false }, msg...) },
+			data:    big.NewInt(1),
+			code:    CodeCustom,
+			message: "failed <big.Int> validation for <Custom>",
+		},
+		{
+			name: "BigInt.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return BigInt().CustomCtx(func(context.Context, *big.Int) error { return ErrInvalid }, msg...)
+			},
+			data:    big.NewInt(1),
+			code:    CodeCustomCtx,
+			message: "failed <big.Int> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "BigRat.Lt",
+			schema:  func(msg ...string) Validatable { return BigRat().Lt(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(1, 2),
+			code:    CodeLt,
+			message: "failed <big.Rat> validation for <Lt(1/2)>",
+		},
+		{
+			name:    "BigRat.Gt",
+			schema:  func(msg ...string) Validatable { return BigRat().Gt(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(1, 2),
+			code:    CodeGt,
+			message: "failed <big.Rat> validation for <Gt(1/2)>",
+		},
+		{
+			name:    "BigRat.Lte",
+			schema:  func(msg ...string) Validatable { return BigRat().Lte(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(2, 3),
+			code:    CodeLte,
+			message: "failed <big.Rat> validation for <Lte(1/2)>",
+		},
+		{
+			name:    "BigRat.Gte",
+			schema:  func(msg ...string) Validatable { return BigRat().Gte(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(1, 3),
+			code:    CodeGte,
+			message: "failed <big.Rat> validation for <Gte(1/2)>",
+		},
+		{
+			name:    "BigRat.Range",
+			schema:  func(msg ...string) Validatable { return BigRat().Range(big.NewRat(1, 3), big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(2, 3),
+			code:    CodeRange,
+			message: "failed <big.Rat> validation for <Range(1/3, 1/2)>",
+		},
+		{
+			name:    "BigRat.Eq",
+			schema:  func(msg ...string) Validatable { return BigRat().Eq(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(1, 3),
+			code:    CodeEq,
+			message: "failed <big.Rat> validation for <Eq(1/2)>",
+		},
+		{
+			name:    "BigRat.NotEq",
+			schema:  func(msg ...string) Validatable { return BigRat().NotEq(big.NewRat(1, 2), msg...) },
+			data:    big.NewRat(1, 2),
+			code:    CodeNotEq,
+			message: "failed <big.Rat> validation for <NotEq(1/2)>",
+		},
+		{
+			name:    "BigRat.Positive",
+			schema:  func(msg ...string) Validatable { return BigRat().Positive(msg...) },
+			data:    big.NewRat(0, 1),
+			code:    CodePositive,
+			message: "failed <big.Rat> validation for <Positive>",
+		},
+		{
+			name:    "BigRat.Negative",
+			schema:  func(msg ...string) Validatable { return BigRat().Negative(msg...) },
+			data:    big.NewRat(0, 1),
+			code:    CodeNegative,
+			message: "failed <big.Rat> validation for <Negative>",
+		},
+		{
+			name:    "BigRat.NonNegative",
+			schema:  func(msg ...string) Validatable { return BigRat().NonNegative(msg...) },
+			data:    big.NewRat(-1, 2),
+			code:    CodeNonNegative,
+			message: "failed <big.Rat> validation for <NonNegative>",
+		},
+		{
+			name:    "BigRat.NonPositive",
+			schema:  func(msg ...string) Validatable { return BigRat().NonPositive(msg...) },
+			data:    big.NewRat(1, 2),
+			code:    CodeNonPositive,
+			message: "failed <big.Rat> validation for <NonPositive>",
+		},
+		{
+			name:    "BigRat.NonZero",
+			schema:  func(msg ...string) Validatable { return BigRat().NonZero(msg...) },
+			data:    big.NewRat(0, 1),
+			code:    CodeNonZero,
+			message: "failed <big.Rat> validation for <NonZero>",
+		},
+		{
+			name:    "BigRat.Scale",
+			schema:  func(msg ...string) Validatable { return BigRat().Scale(1, msg...) },
+			data:    big.NewRat(1, 4),
+			code:    CodeScale,
+			message: "failed <big.Rat> validation for <Scale(1)>",
+			params:  map[string]any{"scale": 1},
+		},
+		{
+			name:    "BigRat.Precision",
+			schema:  func(msg ...string) Validatable { return BigRat().Precision(2, msg...) },
+			data:    big.NewRat(123, 1),
+			code:    CodePrecision,
+			message: "failed <big.Rat> validation for <Precision(2)>",
+			params:  map[string]any{"digits": 2},
+		},
+		{
+			name:    "BigRat.Custom",
+			schema:  func(msg ...string) Validatable { return BigRat().Custom(func(*big.Rat) bool { return false }, msg...) },
+			data:    big.NewRat(1, 2),
+			code:    CodeCustom,
+			message: "failed <big.Rat> validation for <Custom>",
+		},
+		{
+			name: "BigRat.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return BigRat().CustomCtx(func(context.Context, *big.Rat) error { return ErrInvalid }, msg...)
+			},
+			data:    big.NewRat(1, 2),
+			code:    CodeCustomCtx,
+			message: "failed <big.Rat> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "Decimal.Lt",
+			schema:  func(msg ...string) Validatable { return Decimal().Lt("5", msg...) },
+			data:    "5",
+			code:    CodeLt,
+			message: "failed <decimal> validation for <Lt(5)>",
+		},
+		{
+			name:    "Decimal.Gt",
+			schema:  func(msg ...string) Validatable { return Decimal().Gt("5", msg...) },
+			data:    "5.0",
+			code:    CodeGt,
+			message: "failed <decimal> validation for <Gt(5)>",
+		},
+		{
+			name:    "Decimal.Lte",
+			schema:  func(msg ...string) Validatable { return Decimal().Lte("5", msg...) },
+			data:    "5.01",
+			code:    CodeLte,
+			message: "failed <decimal> validation for <Lte(5)>",
+		},
+		{
+			name:    "Decimal.Gte",
+			schema:  func(msg ...string) Validatable { return Decimal().Gte("5", msg...) },
+			data:    "4.99",
+			code:    CodeGte,
+			message: "failed <decimal> validation for <Gte(5)>",
+		},
+		{
+			name:    "Decimal.Range",
+			schema:  func(msg ...string) Validatable { return Decimal().Range("1", "5", msg...) },
+			data:    "6",
+			code:    CodeRange,
+			message: "failed <decimal> validation for <Range(1, 5)>",
+		},
+		{
+			name:    "Decimal.Eq",
+			schema:  func(msg ...string) Validatable { return Decimal().Eq("5", msg...) },
+			data:    "4",
+			code:    CodeEq,
+			message: "failed <decimal> validation for <Eq(5)>",
+		},
+		{
+			name:    "Decimal.NotEq",
+			schema:  func(msg ...string) Validatable { return Decimal().NotEq("5", msg...) },
+			data:    "5.00",
+			code:    CodeNotEq,
+			message: "failed <decimal> validation for <NotEq(5)>",
+		},
+		{
+			name:    "Decimal.Positive",
+			schema:  func(msg ...string) Validatable { return Decimal().Positive(msg...) },
+			data:    "0",
+			code:    CodePositive,
+			message: "failed <decimal> validation for <Positive>",
+		},
+		{
+			name:    "Decimal.Negative",
+			schema:  func(msg ...string) Validatable { return Decimal().Negative(msg...) },
+			data:    "0",
+			code:    CodeNegative,
+			message: "failed <decimal> validation for <Negative>",
+		},
+		{
+			name:    "Decimal.NonNegative",
+			schema:  func(msg ...string) Validatable { return Decimal().NonNegative(msg...) },
+			data:    "-0.5",
+			code:    CodeNonNegative,
+			message: "failed <decimal> validation for <NonNegative>",
+		},
+		{
+			name:    "Decimal.NonPositive",
+			schema:  func(msg ...string) Validatable { return Decimal().NonPositive(msg...) },
+			data:    "0.5",
+			code:    CodeNonPositive,
+			message: "failed <decimal> validation for <NonPositive>",
+		},
+		{
+			name:    "Decimal.NonZero",
+			schema:  func(msg ...string) Validatable { return Decimal().NonZero(msg...) },
+			data:    "0.0",
+			code:    CodeNonZero,
+			message: "failed <decimal> validation for <NonZero>",
+		},
+		{
+			name:    "Decimal.Scale",
+			schema:  func(msg ...string) Validatable { return Decimal().Scale(1, msg...) },
+			data:    "1.25",
+			code:    CodeScale,
+			message: "failed <decimal> validation for <Scale(1)>",
+			params:  map[string]any{"scale": 1},
+		},
+		{
+			name:    "Decimal.Precision",
+			schema:  func(msg ...string) Validatable { return Decimal().Precision(2, msg...) },
+			data:    "123",
+			code:    CodePrecision,
+			message: "failed <decimal> validation for <Precision(2)>",
+			params:  map[string]any{"digits": 2},
+		},
+		{
+			name:    "Decimal.Custom",
+			schema:  func(msg ...string) Validatable { return Decimal().Custom(func(*big.Rat) bool { return false }, msg...) },
+			data:    "1",
+			code:    CodeCustom,
+			message: "failed <decimal> validation for <Custom>",
+		},
+		{
+			name: "Decimal.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return Decimal().CustomCtx(func(context.Context, *big.Rat) error { return ErrInvalid }, msg...)
+			},
+			data:    "1",
+			code:    CodeCustomCtx,
+			message: "failed <decimal> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "Money.MinorUnits",
+			schema:  func(...string) Validatable { return Struct{"amount": Money("currency"), "currency": String()} },
+			data:    payment{"1.234", "USD"},
+			code:    CodeMoneyMinorUnits,
+			path:    "amount",
+			message: "<amount> failed <money> validation for <MinorUnits(USD)>",
+			params:  map[string]any{"currency": "USD", "minor_units": 2},
+			fixed:   true,
+		},
+		{
+			name: "Money.Min",
+			schema: func(msg ...string) Validatable {
+				return Struct{"amount": Money("currency").Min("USD", "1", msg...), "currency": String()}
+			},
+			data:    payment{"0.50", "USD"},
+			code:    CodeMoneyMin,
+			path:    "amount",
+			message: "<amount> failed <money> validation for <Min(USD 1)>",
+			params:  map[string]any{"currency": "USD", "limit": "1"},
+		},
+		{
+			name: "Money.Max",
+			schema: func(msg ...string) Validatable {
+				return Struct{"amount": Money("currency").Max("JPY", "1000", msg...), "currency": String()}
+			},
+			data:    payment{"1001", "JPY"},
+			code:    CodeMoneyMax,
+			path:    "amount",
+			message: "<amount> failed <money> validation for <Max(JPY 1000)>",
+			params:  map[string]any{"currency": "JPY", "limit": "1000"},
+		},
+		{
+			name: "Money.Custom",
+			schema: func(msg ...string) Validatable {
+				return Struct{"amount": Money("currency").Custom(func(*big.Rat, Currency) bool { return false }, msg...), "currency": String()}
+			},
+			data:    payment{"1", "USD"},
+			code:    CodeCustom,
+			path:    "amount",
+			message: "<amount> failed <money> validation for <Custom>",
+		},
+		{
+			name: "Money.CustomCtx",
+			schema: func(msg ...string) Validatable {
+				return Struct{"amount": Money("currency").CustomCtx(func(context.Context, *big.Rat, Currency) error { return ErrInvalid }, msg...), "currency": String()}
+			},
+			data:    payment{"1", "USD"},
+			code:    CodeCustomCtx,
+			path:    "amount",
+			message: "<amount> failed <money> validation for <CustomCtx>",
+			cause:   ErrInvalid,
+		},
+		{
+			name:    "GeoJSON.JSON",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    "{",
+			code:    CodeJSON,
+			message: "failed <GeoJSON> validation for <JSON> (unexpected end of JSON input at line 1, column 2)",
+			params:  map[string]any{"column": 2, "line": 1, "offset": 1},
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.NotObject",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    "[]",
+			code:    CodeGeoJSON,
+			message: "failed <GeoJSON> validation for <GeoJSON> (not an object)",
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.MissingType",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    `{"coordinates":[0,0]}`,
+			code:    CodeGeoJSON,
+			path:    "type",
+			message: "<type> failed <GeoJSON> validation for <GeoJSON> (missing type)",
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.Position",
+			schema:  func(...string) Validatable { return GeoJSON() },
+			data:    `{"type":"Point","coordinates":[200,0]}`,
+			code:    CodeLongitude,
+			path:    "coordinates[0]",
+			message: "<coordinates[0]> failed <GeoJSON> validation for <Point> (longitude 200 out of range)",
+			params:  map[string]any{"longitude": float64(200)},
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.Types",
+			schema:  func(...string) Validatable { return GeoJSON().Types("Polygon") },
+			data:    `{"type":"Point","coordinates":[0,0]}`,
+			code:    CodeGeoJSON,
+			path:    "type",
+			message: "<type> failed <GeoJSON> validation for <Point> (type must be one of [Polygon])",
+			params:  map[string]any{"types": []string{"Polygon"}},
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.RightHandRule",
+			schema:  func(...string) Validatable { return GeoJSON().RightHandRule() },
+			data:    `{"type":"Polygon","coordinates":[[[0,0],[0,1],[1,1],[1,0],[0,0]]]}`,
+			code:    CodeGeoJSONWinding,
+			path:    "coordinates[0]",
+			message: "<coordinates[0]> failed <GeoJSON> validation for <Polygon> (exterior ring must be counterclockwise)",
+			fixed:   true,
+		},
+		{
+			name:    "GeoJSON.Within",
+			schema:  func(...string) Validatable { return GeoJSON().Within(0, 0, 10, 10) },
+			data:    `{"type":"Point","coordinates":[20,5]}`,
+			code:    CodeGeoJSONBounds,
+			path:    "coordinates",
+			message: "<coordinates> failed <GeoJSON> validation for <Point> (position out of bounds)",
+			params:  map[string]any{"bounds": []float64{0, 0, 10, 10}},
+			fixed:   true,
+		},
+		{
+			name:    "Struct.TagNotFound",
+			schema:  func(...string) Validatable { return Struct{"a": String()} },
+			data:    struct{}{},
+			code:    CodeTagNotFound,
+			path:    "a",
+			message: "tag <a> not found for <struct>",
+			fixed:   true,
+		},
+		{
+			name:   "Struct.Field",
+			schema: func(msg ...string) Validatable { return Struct{"name": String().Min(3, msg...)} },
+			data: struct {
+				Name string `z:"name"`
+			}{"ab"},
+			code:    CodeMin,
+			path:    "name",
+			message: "<name> failed <string> validation for <Min(3)>",
+		},
+		{
+			name:    "Lazy.Field",
+			schema:  func(msg ...string) Validatable { return Lazy(func() Validatable { return String().Min(3, msg...) }) },
+			data:    "ab",
+			code:    CodeMin,
+			message: "failed <string> validation for <Min(3)>",
+		},
+		{
+			name:    "Struct.Cycle",
+			schema:  func(...string) Validatable { return nodeSchema(0) },
+			data:    loop(),
+			code:    CodeCycle,
+			path:    "next",
+			message: "<next> failed validation for <struct> (cycle detected)",
+			fixed:   true,
+		},
+		{
+			name:    "Lazy.MaxDepth",
+			schema:  func(...string) Validatable { return nodeSchema(2) },
+			data:    &node{&node{&node{&node{}}}},
+			code:    CodeMaxDepth,
+			path:    "next.next.next",
+			message: "<next.next.next> failed validation for <lazy> (max depth of 2 exceeded)",
+			fixed:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := Issue{Code: tt.code, Path: tt.path, Message: tt.message, Params: tt.params, Cause: tt.cause}
+			checkIssue(t, "untagged", tt.schema().Validate(tt.data), want, tt.volatile)
+
+			// tagged, the path (and the path in the message) is nested under the tag
+			tagged := want
+			if tt.path == "" {
+				tagged.Path, tagged.Message = "field", "<field> "+tt.message
+			} else {
+				tagged.Path = "field." + tt.path
+				tagged.Message = strings.Replace(tt.message, "<"+tt.path+">", "<"+tagged.Path+">", 1)
+			}
+			checkIssue(t, "tagged", tt.schema().Validate(tt.data, "field"), tagged, tt.volatile)
+
+			if !tt.fixed {
+				custom := tagged
+				custom.Message = "custom message"
+				checkIssue(t, "custom", tt.schema("custom message").Validate(tt.data, "field"), custom, tt.volatile)
+			}
+		})
+	}
+}
+
+// checkIssue checks that errs holds exactly the issue want, through every accessor.
+func checkIssue(t *testing.T, form string, errs Errors, want Issue, volatile bool) {
+	t.Helper()
+	if errs == nil {
+		t.Errorf("%s: got no errors, want %q", form, want.Message)
+		return
+	}
+	if got := errs.One(); got != want.Message {
+		t.Errorf("%s: One() = %q, want %q", form, got, want.Message)
+	}
+	if got := errs.All(); len(got) != 1 || got[0] != want.Message {
+		t.Errorf("%s: All() = %q, want [%q]", form, got, want.Message)
+	}
+	issues := errs.Issues()
+	if len(issues) != 1 {
+		t.Errorf("%s: got %d issues, want 1", form, len(issues))
+		return
+	}
+	got := issues[0]
+	if got.Code != want.Code {
+		t.Errorf("%s: Code = %q, want %q", form, got.Code, want.Code)
+	}
+	if got.Path != want.Path {
+		t.Errorf("%s: Path = %q, want %q", form, got.Path, want.Path)
+	}
+	if got.Message != want.Message {
+		t.Errorf("%s: Message = %q, want %q", form, got.Message, want.Message)
+	}
+	if !volatile && !reflect.DeepEqual(got.Params, want.Params) {
+		t.Errorf("%s: Params = %#v, want %#v", form, got.Params, want.Params)
+	}
+	if !errors.Is(got.Cause, want.Cause) {
+		t.Errorf("%s: Cause = %v, want %v", form, got.Cause, want.Cause)
+	}
+}
+
+// A bool given data of another type fails once, for the type, rather than for each of its rules too.
+func TestBoolTypeMismatch(t *testing.T) {
+	for _, schema := range []*ValidatableBool{Bool(), Bool().True(), Bool().False().FailFast()} {
+		errs := schema.Validate("x", "flag")
+		if all := errs.All(); len(all) != 1 || all[0] != "<flag> failed validation for <bool>" {
+			t.Errorf(`Validate("x", "flag") = %q, want exactly ["<flag> failed validation for <bool>"]`, all)
+		}
+	}
+}
+
+// Validate returns errors that aren't validation failures as an issue of their own, with a code telling an invalid
+// schema from an error that stopped validation.
+func TestRunErrorIssues(t *testing.T) {
+	unavailable := errors.New("database unavailable")
+	tests := []struct {
+		name   string
+		schema Validatable
+		data   any
+		code   string
+		cause  error
+	}{
+		{"invalid regex", String().Regex("("), "x", CodeInvalidSchema, ErrInvalidSchema},
+		{"invalid bound", BigInt().Gt(nil), big.NewInt(1), CodeInvalidSchema, ErrInvalidSchema},
+		{"missing tag", Struct{"amount": Money("cur")}, payment{Amount: "1"}, CodeInvalidSchema, ErrInvalidSchema},
+		{"CustomCtx error", String().CustomCtx(func(ctx context.Context, s string) error { return unavailable }), "x", CodeAborted, unavailable},
+	}
+	for _, tt := range tests {
+		for _, tag := range [][]string{nil, {"field"}} {
+			errs := tt.schema.Validate(tt.data, tag...)
+			if errs == nil {
+				t.Errorf("%s: got no errors, want a %q issue", tt.name, tt.code)
+				continue
+			}
+			issues := errs.Issues()
+			got := issues[len(issues)-1]
+			if got.Code != tt.code || !errors.Is(got.Cause, tt.cause) || got.Message != got.Cause.Error() {
+				t.Errorf("%s: got %+v, want a %q issue caused by %v", tt.name, got, tt.code, tt.cause)
+			}
+		}
+	}
+}
+
+// The Errors returned by Validate match the sentinel causes of their issues with errors.Is, and their issues
+// with errors.As.
+func TestErrorsIsAs(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Validatable
+		data   any
+		is     error
+		code   string
+	}{
+		{"type mismatch", Int(), "1", ErrTypeMismatch, CodeType},
+		{"required", String(), nil, ErrRequired, CodeRequired},
+		{"invalid schema", String().Regex("("), "x", ErrInvalidSchema, CodeInvalidSchema},
+		{"nested type mismatch", Struct{"age": Int()}, struct {
+			Age string `z:"age"`
+		}{"1"}, ErrTypeMismatch, CodeType},
+	}
+	for _, tt := range tests {
+		errs := tt.schema.Validate(tt.data)
+		if !errors.Is(errs, tt.is) {
+			t.Errorf("%s: errors.Is(errs, %v) = false, want true", tt.name, tt.is)
+		}
+		var issue *Issue
+		if !errors.As(errs, &issue) || issue.Code != tt.code {
+			t.Errorf("%s: errors.As found %+v, want a %q issue", tt.name, issue, tt.code)
+		}
+	}
+	if errs := Int().Gt(5).Validate(1); errors.Is(errs, ErrTypeMismatch) || errors.Is(errs, ErrRequired) {
+		t.Errorf("errors.Is matched a rule failure to a sentinel it wasn't caused by")
+	}
+}
+
+// Flatten and Format key the issues of nested structs by the path of tags leading to them.
+func TestErrorsByPath(t *testing.T) {
+	type address struct {
+		Zip string `z:"zip"`
+	}
+	type user struct {
+		Name    string  `z:"name"`
+		Address address `z:"address"`
+	}
+	schema := Struct{"name": String().Min(3), "address": Struct{"zip": String().Regex(`^\d{5}$`)}}
+	errs := schema.Validate(user{Name: "al", Address: address{Zip: "x"}})
+
+	flat := errs.Flatten()
+	if len(flat) != 2 || len(flat["name"]) != 1 || len(flat["address.zip"]) != 1 {
+		t.Errorf("Flatten() = %q, want one message each under name and address.zip", flat)
+	}
+	tree := errs.Format()
+	if zip := tree.Fields["address"].Fields["zip"]; zip == nil || len(zip.Errors) != 1 || zip.Errors[0] != flat["address.zip"][0] {
+		t.Errorf("Format() has no message at address.zip")
+	}
+	encoded, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := decoded["address"].(map[string]any)["zip"].(map[string]any)["_errors"]; !ok || len(decoded["_errors"].([]any)) != 0 {
+		t.Errorf("json.Marshal(Format()) = %s, want _errors at every level", encoded)
+	}
+}